@@ -61,9 +61,13 @@ Feature: display all executed Git commands
       | new      | frontend | git checkout existing                         |
       | existing | frontend | git branch -D new                             |
       |          | backend  | git config --unset git-town-branch.new.parent |
+      |          | backend  | git branch -vva                               |
+      |          | backend  | git config -lz --global                       |
+      |          | backend  | git config -lz --local                        |
+      |          | backend  | git stash list                                |
     And it prints:
       """
-      Ran 12 shell commands.
+      Ran 16 shell commands.
       """
     And the current branch is still "existing"
     And the initial commits exist
@@ -0,0 +1,42 @@
+Feature: switch to the bottom of the stack
+
+  Scenario: stack on the main branch
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And the current branch is "gamma"
+    When I run "git-town bottom"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | gamma  | git checkout alpha |
+    And the current branch is now "alpha"
+
+  Scenario: stack on a perennial branch
+    Given a perennial branch "production"
+    And a feature branch "alpha" as a child of "production"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    When I run "git-town bottom"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git checkout alpha |
+    And the current branch is now "alpha"
+
+  Scenario: already at the bottom
+    Given the current branch is a feature branch "alpha"
+    When I run "git-town bottom"
+    Then it runs no commands
+    And it prints:
+      """
+      branch "alpha" is already at the bottom of its stack
+      """
+    And the current branch is still "alpha"
+
+  Scenario: main branch
+    Given the current branch is "main"
+    When I run "git-town bottom"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "main" has no parent branch
+      """
@@ -0,0 +1,27 @@
+Feature: display the local branches as a tree
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "main"
+    And a perennial branch "production"
+    And a parked branch "parked"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE       |
+      | alpha  | local    | local commit  |
+      | gamma  | origin   | origin commit |
+    And the current branch is "beta"
+    And I run "git fetch"
+    When I run "git-town branch"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints:
+      """
+      main (main branch, up to date)
+          alpha (feature branch, not in sync, 1 ahead)
+      *     beta (feature branch, up to date)
+          gamma (feature branch, not in sync, 1 behind)
+          parked (parked branch, up to date)
+        production (perennial branch, up to date)
+      """
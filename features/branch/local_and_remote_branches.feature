@@ -0,0 +1,16 @@
+Feature: display branches that are only local, only remote, or have no known parent
+
+  Scenario: result
+    Given the local feature branch "local"
+    And a remote feature branch "remote"
+    And a branch "unknown"
+    And the current branch is "main"
+    And I run "git fetch"
+    When I run "git-town branch"
+    Then it runs no commands
+    And it prints:
+      """
+      * main (main branch, up to date)
+          local (feature branch, local only)
+        unknown (feature branch, local only)
+      """
@@ -0,0 +1,32 @@
+Feature: compress the commits on a feature branch using a custom commit message
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local, origin | commit 1 | file_1    | content 1    |
+      |         |               | commit 2 | file_2    | content 2    |
+    When I run "git-town compress -m compressed"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                         |
+      | feature | git fetch --prune --tags                        |
+      |         | git reset --soft main                           |
+      |         | git commit -m compressed                        |
+      |         | git push --force-with-lease --force-if-includes |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE    |
+      | feature | local, origin | compressed |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                         |
+      | feature | git reset --hard {{ sha 'commit 2' }}           |
+      |         | git push --force-with-lease --force-if-includes |
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
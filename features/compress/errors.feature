@@ -0,0 +1,53 @@
+Feature: does not compress branches that cannot be compressed
+
+  Scenario: main branch
+    Given the current branch is "main"
+    When I run "git-town compress"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot compress main branch "main"
+      """
+
+  Scenario: branch without commits
+    Given the current branch is a feature branch "feature"
+    When I run "git-town compress"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "feature" has no commits
+      """
+
+  Scenario: branch with a single commit
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  |
+      | feature | local, origin | commit 1 |
+    When I run "git-town compress"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "feature" already has just one commit
+      """
+
+  Scenario: branch not in sync with its parent
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE     |
+      | main    | local, origin | main commit |
+      | feature | local, origin | commit 1    |
+      |         |               | commit 2    |
+    When I run "git-town compress"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "feature" is not in sync, please run "git town sync" first
+      """
@@ -0,0 +1,77 @@
+Feature: compress the commits on all branches of a stack
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | alpha  | local, origin | alpha 1 | alpha_1   | alpha 1      |
+      |        |               | alpha 2 | alpha_2   | alpha 2      |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | beta   | local, origin | beta 1  | beta_1    | beta 1       |
+      |        |               | beta 2  | beta_2    | beta 2       |
+    And a feature branch "gamma" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | gamma  | local, origin | gamma 1 | gamma_1   | gamma 1      |
+    And the current branch is "beta"
+    When I run "git-town compress --stack"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git fetch --prune --tags                        |
+      |        | git checkout alpha                              |
+      | alpha  | git reset --soft main                           |
+      |        | git commit -m "alpha 1"                         |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+      | beta   | git reset --soft alpha                          |
+      |        | git commit -m "beta 1"                          |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --soft beta                           |
+      |        | git commit -m "gamma 1"                         |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+    And all branches are now synchronized
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+      | beta   | local, origin | alpha 1 |
+      |        |               | beta 1  |
+      | gamma  | local, origin | alpha 1 |
+      |        |               | beta 1  |
+      |        |               | gamma 1 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git checkout alpha                              |
+      | alpha  | git reset --hard {{ sha 'alpha 2' }}            |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+      | beta   | git reset --hard {{ sha 'beta 2' }}             |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --hard {{ sha-before-run 'gamma 1' }} |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+      |        |               | alpha 2 |
+      | beta   | local, origin | alpha 1 |
+      |        |               | alpha 2 |
+      |        |               | beta 1  |
+      |        |               | beta 2  |
+      | gamma  | local, origin | alpha 1 |
+      |        |               | alpha 2 |
+      |        |               | beta 1  |
+      |        |               | beta 2  |
+      |        |               | gamma 1 |
+    And the initial branches and lineage exist
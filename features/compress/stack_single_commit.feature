@@ -0,0 +1,67 @@
+Feature: compress a stack that contains branches with a single commit
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | alpha  | local, origin | alpha 1 | alpha_1   | alpha 1      |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | beta   | local, origin | beta 1  | beta_1    | beta 1       |
+      |        |               | beta 2  | beta_2    | beta 2       |
+    And a feature branch "gamma" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | gamma  | local, origin | gamma 1 | gamma_1   | gamma 1      |
+    And the current branch is "beta"
+    When I run "git-town compress --stack"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git fetch --prune --tags                        |
+      |        | git reset --soft alpha                          |
+      |        | git commit -m "beta 1"                          |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --soft beta                           |
+      |        | git commit -m "gamma 1"                         |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+    And all branches are now synchronized
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+      | beta   | local, origin | alpha 1 |
+      |        |               | beta 1  |
+      | gamma  | local, origin | alpha 1 |
+      |        |               | beta 1  |
+      |        |               | gamma 1 |
+    And the branches are now
+      | REPOSITORY    | BRANCHES                 |
+      | local, origin | main, alpha, beta, gamma |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git reset --hard {{ sha 'beta 2' }}             |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --hard {{ sha-before-run 'gamma 1' }} |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+      | beta   | local, origin | alpha 1 |
+      |        |               | beta 1  |
+      |        |               | beta 2  |
+      | gamma  | local, origin | alpha 1 |
+      |        |               | beta 1  |
+      |        |               | beta 2  |
+      |        |               | gamma 1 |
+    And the initial branches and lineage exist
@@ -0,0 +1,58 @@
+Feature: enter the Azure DevOps API token
+
+  Scenario: auto-detected Azure DevOps platform
+    Given my repo's "origin" remote is "https://dev.azure.com/git-town/git-town/_git/git-town"
+    When I run "git-town config setup" and enter into the dialog:
+      | DIALOG                        | KEYS              | DESCRIPTION                                 |
+      | welcome                       | enter             |                                             |
+      | aliases                       | enter             |                                             |
+      | main development branch       | enter             |                                             |
+      | perennial branches            |                   | no input here since the dialog doesn't show |
+      | perennial regex               | enter             |                                             |
+      | hosting platform: auto-detect | enter             |                                             |
+      | azure devops token            | 1 2 3 4 5 6 enter |                                             |
+      | origin hostname               | enter             |                                             |
+      | sync-feature-strategy         | enter             |                                             |
+      | sync-perennial-strategy       | enter             |                                             |
+      | sync-upstream                 | enter             |                                             |
+      | push-new-branches             | enter             |                                             |
+      | push-hook                     | enter             |                                             |
+      | ship-delete-tracking-branch   | enter             |                                             |
+      | sync-before-ship              | enter             |                                             |
+      | save config to Git metadata   | down enter        |                                             |
+    Then it runs the commands
+      | COMMAND                                       |
+      | git config git-town.azure-devops-token 123456 |
+    And local Git Town setting "hosting-platform" still doesn't exist
+    And local Git Town setting "azure-devops-token" is now "123456"
+
+  Scenario: select Azure DevOps manually
+    When I run "git-town config setup" and enter into the dialog:
+      | DIALOG                      | KEYS              | DESCRIPTION                                 |
+      | welcome                     | enter             |                                             |
+      | aliases                     | enter             |                                             |
+      | main development branch     | enter             |                                             |
+      | perennial branches          |                   | no input here since the dialog doesn't show |
+      | perennial regex             | enter             |                                             |
+      | hosting platform            | down enter        |                                             |
+      | azure devops token          | 1 2 3 4 5 6 enter |                                             |
+      | origin hostname             | enter             |                                             |
+      | sync-feature-strategy       | enter             |                                             |
+      | sync-perennial-strategy     | enter             |                                             |
+      | sync-upstream               | enter             |                                             |
+      | push-new-branches           | enter             |                                             |
+      | push-hook                   | enter             |                                             |
+      | ship-delete-tracking-branch | enter             |                                             |
+      | sync-before-ship            | enter             |                                             |
+      | save config to Git metadata | down enter        |                                             |
+    Then it runs the commands
+      | COMMAND                                           |
+      | git config git-town.azure-devops-token 123456     |
+      | git config git-town.hosting-platform azure-devops |
+    And local Git Town setting "hosting-platform" is now "azure-devops"
+    And local Git Town setting "azure-devops-token" is now "123456"
+
+  Scenario: undo
+    When I run "git-town undo"
+    And local Git Town setting "hosting-platform" now doesn't exist
+    And local Git Town setting "azure-devops-token" now doesn't exist
@@ -0,0 +1,33 @@
+Feature: enter the Bitbucket Data Center API token
+
+  Scenario: select Bitbucket Data Center manually
+    Given my repo's "origin" remote is "ssh://git@bitbucket.example.com:7999/git-town/git-town.git"
+    When I run "git-town config setup" and enter into the dialog:
+      | DIALOG                      | KEYS                 | DESCRIPTION                                 |
+      | welcome                     | enter                |                                             |
+      | aliases                     | enter                |                                             |
+      | main development branch     | enter                |                                             |
+      | perennial branches          |                      | no input here since the dialog doesn't show |
+      | perennial regex             | enter                |                                             |
+      | hosting platform            | down down down enter |                                             |
+      | bitbucket data center token | 1 2 3 4 5 6 enter    |                                             |
+      | origin hostname             | enter                |                                             |
+      | sync-feature-strategy       | enter                |                                             |
+      | sync-perennial-strategy     | enter                |                                             |
+      | sync-upstream               | enter                |                                             |
+      | push-new-branches           | enter                |                                             |
+      | push-hook                   | enter                |                                             |
+      | ship-delete-tracking-branch | enter                |                                             |
+      | sync-before-ship            | enter                |                                             |
+      | save config to Git metadata | down enter           |                                             |
+    Then it runs the commands
+      | COMMAND                                                   |
+      | git config git-town.bitbucket-datacenter-token 123456     |
+      | git config git-town.hosting-platform bitbucket-datacenter |
+    And local Git Town setting "hosting-platform" is now "bitbucket-datacenter"
+    And local Git Town setting "bitbucket-datacenter-token" is now "123456"
+
+  Scenario: undo
+    When I run "git-town undo"
+    And local Git Town setting "hosting-platform" now doesn't exist
+    And local Git Town setting "bitbucket-datacenter-token" now doesn't exist
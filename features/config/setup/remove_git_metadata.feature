@@ -34,7 +34,7 @@ Feature: remove existing configuration in Git metadata
       | keep the already configured main branch | enter                                         |
       | change the perennial branches           | space down space enter                        |
       | remove the perennial regex              | backspace backspace backspace backspace enter |
-      | remove hosting service override         | up up up enter                                |
+      | remove hosting service override         | up up up up up up enter                       |
       | remove origin hostname                  | backspace backspace backspace backspace enter |
       | sync-feature-strategy                   | down enter                                    |
       | sync-perennial-strategy                 | down enter                                    |
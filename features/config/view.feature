@@ -18,14 +18,18 @@ Feature: show the configuration
         parked branches: parked-1, parked-2
         contribution branches: contribution-1, contribution-2
         observed branches: observed-1, observed-2
+        prototype branches: (none)
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
+        proposals show the stack: no
         ship deletes the tracking branch: yes
+        ship strategy: squash-merge
         sync-feature strategy: merge
         sync-perennial strategy: rebase
+        sync pushes branches: yes
         sync with upstream: yes
         sync before shipping: no
 
@@ -34,13 +38,21 @@ Feature: show the configuration
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Forgejo token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Bitbucket Data Center token: (not set)
+        Azure DevOps token: (not set)
       """
 
   Scenario: all configured in config file
     Given the configuration file:
       """
+      proposals-show-stack = true
       push-new-branches = true
       ship-delete-tracking-branch = true
+      ship-strategy = "fast-forward"
+      sync-push = false
       sync-upstream = true
 
       [branches]
@@ -66,14 +78,18 @@ Feature: show the configuration
         parked branches: (none)
         contribution branches: (none)
         observed branches: (none)
+        prototype branches: (none)
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: yes
+        proposals show the stack: yes
         ship deletes the tracking branch: yes
+        ship strategy: fast-forward
         sync-feature strategy: rebase
         sync-perennial strategy: merge
+        sync pushes branches: no
         sync with upstream: yes
         sync before shipping: no
 
@@ -82,6 +98,11 @@ Feature: show the configuration
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Forgejo token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Bitbucket Data Center token: (not set)
+        Azure DevOps token: (not set)
       """
 
   Scenario: configured in both Git and config file
@@ -93,6 +114,8 @@ Feature: show the configuration
     And Git Town setting "perennial-regex" is "git-perennial-.*"
     And Git Town setting "push-new-branches" is "false"
     And Git Town setting "ship-delete-tracking-branch" is "false"
+    And Git Town setting "ship-strategy" is "always-merge"
+    And Git Town setting "sync-push" is "false"
     And Git Town setting "sync-upstream" is "false"
     And Git Town setting "sync-perennial-strategy" is "merge"
     And Git Town setting "sync-feature-strategy" is "merge"
@@ -125,14 +148,18 @@ Feature: show the configuration
         parked branches: parked-1, parked-2
         contribution branches: contribution-1, contribution-2
         observed branches: observed-1, observed-2
+        prototype branches: (none)
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
+        proposals show the stack: no
         ship deletes the tracking branch: no
+        ship strategy: always-merge
         sync-feature strategy: merge
         sync-perennial strategy: merge
+        sync pushes branches: no
         sync with upstream: no
         sync before shipping: no
 
@@ -141,6 +168,11 @@ Feature: show the configuration
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Forgejo token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Bitbucket Data Center token: (not set)
+        Azure DevOps token: (not set)
       """
 
   Scenario: all configured, with stacked changes
@@ -158,14 +190,18 @@ Feature: show the configuration
         parked branches: (none)
         contribution branches: (none)
         observed branches: (none)
+        prototype branches: (none)
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
+        proposals show the stack: no
         ship deletes the tracking branch: yes
+        ship strategy: squash-merge
         sync-feature strategy: merge
         sync-perennial strategy: rebase
+        sync pushes branches: yes
         sync with upstream: yes
         sync before shipping: no
 
@@ -174,6 +210,11 @@ Feature: show the configuration
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Forgejo token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Bitbucket Data Center token: (not set)
+        Azure DevOps token: (not set)
 
       Branch Lineage:
         main
@@ -197,14 +238,18 @@ Feature: show the configuration
         parked branches: (none)
         contribution branches: (none)
         observed branches: (none)
+        prototype branches: (none)
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
+        proposals show the stack: no
         ship deletes the tracking branch: yes
+        ship strategy: squash-merge
         sync-feature strategy: merge
         sync-perennial strategy: rebase
+        sync pushes branches: yes
         sync with upstream: yes
         sync before shipping: no
 
@@ -213,4 +258,9 @@ Feature: show the configuration
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Forgejo token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
+        Bitbucket Data Center token: (not set)
+        Azure DevOps token: (not set)
       """
@@ -0,0 +1,61 @@
+Feature: detach the current branch from its stack
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | alpha  | local, origin | alpha 1 | alpha_1   | alpha 1      |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | beta   | local, origin | beta 1  | beta_1    | beta 1       |
+    And a feature branch "gamma" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | gamma  | local, origin | gamma 1 | gamma_1   | gamma 1      |
+    And the current branch is "beta"
+    When I run "git-town detach"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                               |
+      | beta   | git fetch --prune --tags                              |
+      |        | git rebase --onto main alpha                          |
+      |        | git push --force-with-lease --force-if-includes       |
+      |        | git checkout gamma                                    |
+      | gamma  | git rebase --onto alpha {{ sha-before-run 'beta 1' }} |
+      |        | git push --force-with-lease --force-if-includes       |
+      |        | git checkout beta                                     |
+    And it prints:
+      """
+      branch "beta" is now a child of "main"
+      """
+    And it prints:
+      """
+      branch "gamma" is now a child of "alpha"
+      """
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+      | beta   | local, origin | beta 1  |
+      | gamma  | local, origin | alpha 1 |
+      |        |               | gamma 1 |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | main   |
+      | gamma  | alpha  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git reset --hard {{ sha-before-run 'beta 1' }}  |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --hard {{ sha-before-run 'gamma 1' }} |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+    And the current branch is still "beta"
+    And the initial branches and lineage exist
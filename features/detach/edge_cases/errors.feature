@@ -0,0 +1,51 @@
+Feature: does not detach branches that cannot be detached
+
+  Scenario: parent is the main branch
+    Given the current branch is a feature branch "feature"
+    When I run "git-town detach"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "feature" is already a child of the main branch
+      """
+
+  Scenario: current branch is the main branch
+    Given the current branch is "main"
+    When I run "git-town detach"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot detach main branch "main"
+      """
+
+  Scenario: current branch is not in sync with its parent
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+    And the current branch is "beta"
+    When I run "git-town detach"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "beta" is not in sync, please run "git town sync" first
+      """
+
+  Scenario: uncommitted changes
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    And an uncommitted file
+    When I run "git-town detach"
+    Then it runs no commands
+    And it prints the error:
+      """
+      you have uncommitted changes
+      """
@@ -0,0 +1,21 @@
+Feature: switch to the parent branch
+
+  Scenario: feature branch
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    When I run "git-town down"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git checkout alpha |
+    And the current branch is now "alpha"
+
+  Scenario: main branch
+    Given the current branch is "main"
+    When I run "git-town down"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "main" has no parent branch
+      """
+    And the current branch is still "main"
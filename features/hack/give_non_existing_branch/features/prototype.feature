@@ -0,0 +1,38 @@
+Feature: create a new prototype branch
+
+  Background:
+    Given Git Town setting "push-new-branches" is "true"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE       |
+      | main   | origin   | origin commit |
+    And the current branch is "main"
+    When I run "git-town hack --prototype new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+      |        | git rebase origin/main   |
+      |        | git branch new main      |
+      |        | git checkout new         |
+    And the current branch is now "new"
+    And branch "new" is now a prototype branch
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | main   | local, origin | origin commit |
+      | new    | local         | origin commit |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | new    | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                     |
+      | new    | git checkout main                           |
+      | main   | git reset --hard {{ sha 'initial commit' }} |
+      |        | git branch -D new                           |
+    And the current branch is now "main"
+    And the initial commits exist
+    And the initial branches and lineage exist
+    And there are now no prototype branches
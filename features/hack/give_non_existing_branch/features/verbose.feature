@@ -58,8 +58,12 @@ Feature: display all executed Git commands
       | main   | frontend | git reset --hard {{ sha 'initial commit' }}   |
       |        | frontend | git branch -D new                             |
       |        | backend  | git config --unset git-town-branch.new.parent |
+      |        | backend  | git branch -vva                               |
+      |        | backend  | git config -lz --global                       |
+      |        | backend  | git config -lz --local                        |
+      |        | backend  | git stash list                                |
     And it prints:
       """
-      Ran 14 shell commands.
+      Ran 18 shell commands.
       """
     And the current branch is now "main"
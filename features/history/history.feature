@@ -0,0 +1,38 @@
+Feature: display the history of Git Town commands
+
+  Scenario: commands ran
+    Given offline mode is enabled
+    And I ran "git-town hack alpha"
+    And I ran "git-town hack beta"
+    When I run "git-town history"
+    Then it prints something like:
+      """
+      1  \d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}  hack  beta
+      2  \d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}  hack  alpha
+      """
+
+  Scenario: undone commands disappear from the history
+    Given offline mode is enabled
+    And I ran "git-town hack alpha"
+    And I ran "git-town hack beta"
+    And I ran "git-town undo"
+    When I run "git-town history"
+    Then it prints something like:
+      """
+      1  \d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}  hack  alpha
+      """
+
+  Scenario: commands that don't change branches
+    Given I ran "git-town offline yes"
+    When I run "git-town history"
+    Then it prints something like:
+      """
+      1  \d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}  offline  \(no branches changed\)
+      """
+
+  Scenario: no commands ran
+    When I run "git-town history"
+    Then it prints:
+      """
+      no Git Town commands have run in this repository yet
+      """
@@ -0,0 +1,52 @@
+Feature: delete multiple branches
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta"
+    And a feature branch "gamma" as a child of "alpha"
+    And a feature branch "delta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+      | delta  | local, origin | delta commit |
+      | gamma  | local, origin | gamma commit |
+    And the current branch is "delta"
+    When I run "git-town kill alpha beta"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | delta  | git fetch --prune --tags |
+      |        | git push origin :alpha   |
+      |        | git branch -D alpha      |
+      |        | git push origin :beta    |
+      |        | git branch -D beta       |
+    And it prints:
+      """
+      branch "gamma" is now a child of "main"
+      """
+    And the current branch is still "delta"
+    And the branches are now
+      | REPOSITORY    | BRANCHES           |
+      | local, origin | main, delta, gamma |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | delta  | local, origin | delta commit |
+      | gamma  | local, origin | gamma commit |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | delta  | main   |
+      | gamma  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | delta  | git branch alpha {{ sha 'alpha commit' }} |
+      |        | git push -u origin alpha                  |
+      |        | git branch beta {{ sha 'beta commit' }}   |
+      |        | git push -u origin beta                   |
+    And the current branch is still "delta"
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,16 @@
+Feature: does not kill any branch if one of the given branches is perennial
+
+  Scenario: perennial branch among the given branches
+    Given a feature branch "feature"
+    And a perennial branch "qa"
+    And the current branch is "main"
+    When I run "git-town kill feature qa"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      you cannot kill perennial branches
+      """
+    And the current branch is still "main"
+    And the initial branches and lineage exist
@@ -0,0 +1,52 @@
+Feature: delete the current branch and all its descendants
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And a feature branch "other"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+      | gamma  | local, origin | gamma commit |
+      | other  | local, origin | other commit |
+    And the current branch is "beta" and the previous branch is "gamma"
+    And an uncommitted file
+    When I run "git-town kill --stack"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                     |
+      | beta   | git fetch --prune --tags    |
+      |        | git push origin :gamma      |
+      |        | git branch -D gamma         |
+      |        | git push origin :beta       |
+      |        | git add -A                  |
+      |        | git commit -m "WIP on beta" |
+      |        | git checkout alpha          |
+      | alpha  | git branch -D beta          |
+    And the current branch is now "alpha"
+    And no uncommitted files exist
+    And the branches are now
+      | REPOSITORY    | BRANCHES           |
+      | local, origin | main, alpha, other |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | other  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                 |
+      | alpha  | git branch gamma {{ sha 'gamma commit' }}               |
+      |        | git push -u origin gamma                                |
+      |        | git push origin {{ sha 'beta commit' }}:refs/heads/beta |
+      |        | git branch beta {{ sha 'WIP on beta' }}                 |
+      |        | git checkout beta                                       |
+      | beta   | git reset --soft HEAD~1                                 |
+    And the current branch is now "beta"
+    And the uncommitted file still exists
+    And the initial commits exist
+    And the initial branches and lineage exist
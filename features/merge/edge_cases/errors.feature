@@ -0,0 +1,67 @@
+Feature: does not merge branches that cannot be merged
+
+  Scenario: parent is the main branch
+    Given the current branch is a feature branch "feature"
+    When I run "git-town merge"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot merge into main branch "main"
+      """
+
+  Scenario: current branch is a perennial branch
+    Given the current branch is a perennial branch "qa"
+    When I run "git-town merge"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | qa     | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot merge perennial branch "qa"
+      """
+
+  Scenario: parent is not in sync with its tracking branch
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE |
+      | alpha  | local    | alpha 1 |
+    And the current branch is "beta"
+    When I run "git-town merge"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "alpha" is not in sync, please run "git town sync" first
+      """
+
+  Scenario: current branch is not in sync with its parent
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+    And the current branch is "beta"
+    When I run "git-town merge"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "beta" is not in sync, please run "git town sync" first
+      """
+
+  Scenario: uncommitted changes
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    And an uncommitted file
+    When I run "git-town merge"
+    Then it runs no commands
+    And it prints the error:
+      """
+      you have uncommitted changes
+      """
@@ -0,0 +1,58 @@
+Feature: merge the current branch into its parent
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | alpha  | local, origin | alpha 1 | alpha_1   | alpha 1      |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | beta   | local, origin | beta 1  | beta_1    | beta 1       |
+    And a feature branch "gamma" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | gamma  | local, origin | gamma 1 | gamma_1   | gamma 1      |
+    And the current branch is "beta"
+    When I run "git-town merge"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+      |        | git checkout alpha       |
+      | alpha  | git merge --no-edit beta |
+      |        | git push                 |
+      |        | git push origin :beta    |
+      |        | git branch -D beta       |
+    And it prints:
+      """
+      branch "gamma" is now a child of "alpha"
+      """
+    And the current branch is now "alpha"
+    And the branches are now
+      | REPOSITORY    | BRANCHES           |
+      | local, origin | main, alpha, gamma |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+      |        |               | beta 1  |
+      | gamma  | local, origin | alpha 1 |
+      |        |               | beta 1  |
+      |        |               | gamma 1 |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | gamma  | alpha  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | alpha  | git reset --hard {{ sha 'alpha 1' }}            |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git branch beta {{ sha 'beta 1' }}              |
+      |        | git push -u origin beta                         |
+      |        | git checkout beta                               |
+    And the current branch is now "beta"
+    And the initial branches and lineage exist
@@ -0,0 +1,47 @@
+Feature: merge a branch that has a proposal
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | alpha  | local, origin | alpha 1 | alpha_1   | alpha 1      |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | beta   | local, origin | beta 1  | beta_1    | beta 1       |
+    And a feature branch "gamma" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | gamma  | local, origin | gamma 1 | gamma_1   | gamma 1      |
+    And the current branch is "beta"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a GitHub API stand-in with the proposals
+      | NUMBER | BRANCH | TARGET |
+      | 1      | alpha  | main   |
+      | 2      | beta   | alpha  |
+      | 3      | gamma  | beta   |
+    When I run "git-town merge"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                           |
+      | beta   | git fetch --prune --tags                          |
+      |        | git checkout alpha                                |
+      | alpha  | git merge --no-edit beta                          |
+      |        | git push                                          |
+      | <none> | GitHub API: updating base branch for PR #3 ... ok |
+      |        | GitHub API: closing PR #2 ... ok                  |
+      | alpha  | git push origin :beta                             |
+      |        | git branch -D beta                                |
+    And the GitHub API stand-in received these changes
+      | METHOD | PATH                             | BODY               |
+      | PATCH  | /repos/git-town/git-town/pulls/3 | {"base":"alpha"}   |
+      | PATCH  | /repos/git-town/git-town/pulls/2 | {"state":"closed"} |
+    And the current branch is now "alpha"
+    And the branches are now
+      | REPOSITORY    | BRANCHES           |
+      | local, origin | main, alpha, gamma |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | gamma  | alpha  |
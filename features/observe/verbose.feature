@@ -47,9 +47,13 @@ Feature: observe a branch verbosely
       |        | git show-ref --verify --quiet refs/heads/     |
       |        | git stash list                                |
       | branch | git stash pop                                 |
+      | <none> | git branch -vva                               |
+      |        | git config -lz --global                       |
+      |        | git config -lz --local                        |
+      |        | git stash list                                |
     And it prints:
       """
-      Ran 15 shell commands
+      Ran 19 shell commands
       """
     And the current branch is still "branch"
     And branch "branch" is now a feature branch
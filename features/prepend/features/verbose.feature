@@ -64,8 +64,12 @@ Feature: display all executed Git commands
       | old    | frontend | git branch -D parent                             |
       |        | backend  | git config --unset git-town-branch.parent.parent |
       |        | backend  | git config git-town-branch.old.parent main       |
+      |        | backend  | git branch -vva                                  |
+      |        | backend  | git config -lz --global                          |
+      |        | backend  | git config -lz --local                           |
+      |        | backend  | git stash list                                   |
     And it prints:
       """
-      Ran 13 shell commands.
+      Ran 17 shell commands.
       """
     And the current branch is now "old"
@@ -0,0 +1,13 @@
+Feature: provide the proposal body both inline and via a file
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    When I run "git-town propose --body body --body-file body.txt"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      the --body and --body-file flags cannot be used together
+      """
+    And the current branch is still "feature"
@@ -0,0 +1,13 @@
+Feature: read the proposal body from a non-existing file
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    When I run "git-town propose --body-file zonk.txt"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot read the proposal body from file "zonk.txt"
+      """
+    And the current branch is still "feature"
@@ -10,7 +10,10 @@ Feature: unsupported hosting platform
       unsupported hosting platform
 
       This command requires hosting on one of these services:
+      * Azure DevOps
       * Bitbucket
+      * Bitbucket Data Center
+      * Forgejo
       * GitHub
       * GitLab
       * Gitea
@@ -13,11 +13,14 @@ Feature: self-hosted service
       """
 
     Examples:
-      | PLATFORM  | PROPOSAL_URL                                                                                                                              |
-      | bitbucket | https://self-hosted/git-town/git-town/pull-requests/new?source=feature&dest=git-town%2Fgit-town%3Amain                                    |
-      | github    | https://self-hosted/git-town/git-town/compare/feature?expand=1                                                                            |
-      | gitea     | https://self-hosted/git-town/git-town/compare/main...feature                                                                              |
-      | gitlab    | https://self-hosted/git-town/git-town/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main |
+      | PLATFORM             | PROPOSAL_URL                                                                                                                                   |
+      | azure-devops         | https://self-hosted/git-town/_git/git-town/pullrequestcreate?sourceRef=feature&targetRef=main                                                  |
+      | bitbucket            | https://self-hosted/git-town/git-town/pull-requests/new?source=feature&dest=git-town%2Fgit-town%3Amain                                         |
+      | bitbucket-datacenter | https://self-hosted/projects/git-town/repos/git-town/pull-requests?create&sourceBranch=refs%2Fheads%2Ffeature&targetBranch=refs%2Fheads%2Fmain |
+      | forgejo              | https://self-hosted/git-town/git-town/compare/main...feature                                                                                   |
+      | github               | https://self-hosted/git-town/git-town/compare/feature?expand=1                                                                                 |
+      | gitea                | https://self-hosted/git-town/git-town/compare/main...feature                                                                                   |
+      | gitlab               | https://self-hosted/git-town/git-town/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main      |
 
   Scenario: GitLab with custom port
     Given the origin is "ssh://git@git.example.com:4022/a/b.git"
@@ -0,0 +1,44 @@
+Feature: create proposals via the GitHub API
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "secret"
+    And a GitHub API stand-in with the proposals
+      | NUMBER | BRANCH | TARGET |
+
+  Scenario: result
+    When I run "git-town propose --body 'my body'"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                             |
+      | feature | git fetch --prune --tags                            |
+      |         | git checkout main                                   |
+      | main    | git rebase origin/main                              |
+      |         | git checkout feature                                |
+      | feature | git merge --no-edit origin/feature                  |
+      |         | git merge --no-edit main                            |
+      | <none>  | GitHub API: creating PR for branch "feature" ... ok |
+    And it prints:
+      """
+      created proposal https://github.com/git-town/git-town/pull/1
+      """
+    And the GitHub API stand-in received these changes
+      | METHOD | PATH                           | BODY                                                                                     |
+      | POST   | /repos/git-town/git-town/pulls | {"title":"feature commit","head":"feature","base":"main","body":"my body","draft":false} |
+
+  Scenario: dry run
+    When I run "git-town propose --dry-run"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                          |
+      | feature | git fetch --prune --tags                                         |
+      |         | git checkout main                                                |
+      | main    | git rebase origin/main                                           |
+      |         | git checkout feature                                             |
+      | feature | git merge --no-edit origin/feature                               |
+      |         | git merge --no-edit main                                         |
+      | <none>  | creating proposal for branch "feature" via the API ... (dry run) |
+    And the GitHub API stand-in received these changes
+      | METHOD | PATH | BODY |
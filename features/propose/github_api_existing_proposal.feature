@@ -0,0 +1,29 @@
+Feature: proposing a branch that already has a proposal via the GitHub API
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "secret"
+    And a GitHub API stand-in with the proposals
+      | NUMBER | BRANCH  | TARGET |
+      | 1      | feature | main   |
+    When I run "git-town propose"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      |         | git checkout main                  |
+      | main    | git rebase origin/main             |
+      |         | git checkout feature               |
+      | feature | git merge --no-edit origin/feature |
+      |         | git merge --no-edit main           |
+    And it prints:
+      """
+      found existing proposal https://github.com/git-town/git-town/pull/1
+      """
+    And the GitHub API stand-in received these changes
+      | METHOD | PATH | BODY |
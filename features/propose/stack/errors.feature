@@ -0,0 +1,27 @@
+Feature: errors when proposing a stack
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    And the origin is "git@github.com:git-town/git-town.git"
+
+  Scenario: no API token
+    When I run "git-town propose --stack"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      proposing a stack requires an API token for your code hosting platform
+      """
+    And the current branch is still "beta"
+
+  Scenario: title given
+    When I run "git-town propose --stack --title title"
+    Then it runs no commands
+    And it prints the error:
+      """
+      the --title flag cannot be used with --stack because each proposal needs its own title
+      """
+    And the current branch is still "beta"
@@ -0,0 +1,25 @@
+Feature: proposing a stack that contains a parked branch that doesn't exist at the remote
+
+  Background:
+    Given a feature branch "alpha"
+    And a local feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And Git Town setting "parked-branches" is "beta"
+    And the current branch is "gamma"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "secret"
+    And a GitHub API stand-in with the proposals
+      | NUMBER | BRANCH | TARGET |
+    When I run "git-town propose --stack"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | gamma  | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot propose the stack because the parked branch "beta" doesn't exist at the remote yet, please unpark it or push it first
+      """
+    And the current branch is still "gamma"
+    And the GitHub API stand-in received these changes
+      | METHOD | PATH | BODY |
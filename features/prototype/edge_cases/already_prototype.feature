@@ -0,0 +1,13 @@
+Feature: make a branch that is already a prototype branch a prototype branch
+
+  Background:
+    Given the current branch is a prototype branch "prototype"
+    When I run "git-town prototype"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "prototype" is already a prototype branch
+      """
+    And branch "prototype" is still a prototype branch
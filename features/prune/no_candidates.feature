@@ -0,0 +1,18 @@
+Feature: no branches to prune
+
+  Scenario: result
+    Given a feature branch "active"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       |
+      | active | local, origin | active commit |
+    And the current branch is "active"
+    When I run "git-town prune"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | active | git fetch --prune --tags |
+    And it prints:
+      """
+      no branches to prune
+      """
+    And the current branch is still "active"
+    And the initial branches and lineage exist
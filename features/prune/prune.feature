@@ -0,0 +1,37 @@
+Feature: delete branches that were squash-merged into the main branch
+
+  Background:
+    Given a feature branch "shipped"
+    And a feature branch "active"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME    | FILE CONTENT    |
+      | main    | origin        | squash commit  | shipped_file | shipped content |
+      | shipped | local, origin | shipped commit | shipped_file | shipped content |
+      | active  | local, origin | active commit  | active_file  | active content  |
+    And the current branch is "active"
+    When I run "git-town prune" and enter into the dialog:
+      | DIALOG         | KEYS  |
+      | prune branches | enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | active | git fetch --prune --tags |
+      |        | git push origin :shipped |
+      |        | git branch -D shipped    |
+    And the current branch is still "active"
+    And the branches are now
+      | REPOSITORY    | BRANCHES     |
+      | local, origin | main, active |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | active | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                       |
+      | active | git branch shipped {{ sha 'shipped commit' }} |
+      |        | git push -u origin shipped                    |
+    And the current branch is still "active"
+    And the initial branches and lineage exist
@@ -0,0 +1,47 @@
+Feature: select which of the squash-merged branches to delete
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta"
+    And a feature branch "child" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | main   | origin        | squash alpha | alpha_file | alpha content |
+      |        |               | squash beta  | beta_file  | beta content  |
+      | alpha  | local, origin | alpha commit | alpha_file | alpha content |
+      | beta   | local, origin | beta commit  | beta_file  | beta content  |
+      | child  | local, origin | child commit | child_file | child content |
+    And the current branch is "alpha"
+    When I run "git-town prune" and enter into the dialog:
+      | DIALOG         | KEYS             |
+      | prune branches | down space enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | alpha  | git fetch --prune --tags |
+      |        | git push origin :alpha   |
+      |        | git checkout main        |
+      | main   | git branch -D alpha      |
+    And it prints:
+      """
+      branch "child" is now a child of "main"
+      """
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES          |
+      | local, origin | main, beta, child |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | beta   | main   |
+      | child  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | main   | git branch alpha {{ sha 'alpha commit' }} |
+      |        | git push -u origin alpha                  |
+      |        | git checkout alpha                        |
+    And the current branch is now "alpha"
+    And the initial branches and lineage exist
@@ -0,0 +1,10 @@
+Feature: nothing to redo
+
+  Scenario: no undo ran
+    Given I ran "git-town hack feature"
+    When I run "git-town redo"
+    Then it runs no commands
+    And it prints:
+      """
+      nothing to redo
+      """
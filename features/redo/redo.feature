@@ -0,0 +1,70 @@
+Feature: redo the most recently undone command
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "main"
+    And I ran "git-town kill feature"
+    And I ran "git-town undo"
+
+  Scenario: result
+    When I run "git-town redo"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git push origin :feature |
+      |        | git branch -D feature    |
+    And the current branch is still "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And no lineage exists now
+
+  Scenario: undo the redo
+    Given I ran "git-town redo"
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+    And the current branch is still "main"
+    And the initial branches and lineage exist
+
+  Scenario: redo twice
+    Given I ran "git-town redo"
+    When I run "git-town redo"
+    Then it runs no commands
+    And it prints:
+      """
+      nothing to redo
+      """
+
+  Scenario: another Git Town command ran since the undo
+    Given I ran "git-town hack other"
+    When I run "git-town redo"
+    Then it runs no commands
+    And it prints:
+      """
+      nothing to redo
+      """
+
+  Scenario: branch changed since the undo
+    Given the commits
+      | BRANCH  | LOCATION | MESSAGE           | FILE NAME       |
+      | feature | local    | additional commit | additional_file |
+    When I run "git-town redo"
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot redo because these branches have changed since the undo: feature
+      """
+
+  Scenario: configuration changed since the undo
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    When I run "git-town redo"
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot redo because the Git Town configuration has changed since the undo
+      """
@@ -63,8 +63,12 @@ Feature: display all executed Git commands
       | old    | frontend | git branch -D new                             |
       |        | backend  | git config --unset git-town-branch.new.parent |
       |        | backend  | git config git-town-branch.old.parent main    |
+      |        | backend  | git branch -vva                               |
+      |        | backend  | git config -lz --global                       |
+      |        | backend  | git config -lz --local                        |
+      |        | backend  | git stash list                                |
     And it prints:
       """
-      Ran 16 shell commands.
+      Ran 20 shell commands.
       """
     And the current branch is now "old"
@@ -12,11 +12,33 @@ Feature: self hosted servie
       """
 
     Examples:
-      | DRIVER    | REPO_URL                              |
-      | bitbucket | https://self-hosted/git-town/git-town |
-      | github    | https://self-hosted/git-town/git-town |
-      | gitea     | https://self-hosted/git-town/git-town |
-      | gitlab    | https://self-hosted/git-town/git-town |
+      | DRIVER               | REPO_URL                                             |
+      | azure-devops         | https://self-hosted/git-town/_git/git-town           |
+      | bitbucket            | https://self-hosted/git-town/git-town                |
+      | bitbucket-datacenter | https://self-hosted/projects/git-town/repos/git-town |
+      | forgejo              | https://self-hosted/git-town/git-town                |
+      | github               | https://self-hosted/git-town/git-town                |
+      | gitea                | https://self-hosted/git-town/git-town                |
+      | gitlab               | https://self-hosted/git-town/git-town                |
+
+  Scenario: Bitbucket Data Center with HTTPS remote
+    Given the origin is "https://bitbucket.example.com/scm/proj/repo.git"
+    And Git Town setting "hosting-platform" is "bitbucket-datacenter"
+    And tool "open" is installed
+    When I run "git-town repo"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://bitbucket.example.com/projects/proj/repos/repo
+      """
+
+  Scenario: Azure DevOps with SSH remote
+    Given the origin is "git@ssh.dev.azure.com:v3/git-town/git-town/git-town"
+    And tool "open" is installed
+    When I run "git-town repo"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://dev.azure.com/git-town/git-town/_git/git-town
+      """
 
   Scenario: GitLab with custom port
     Given the origin is "ssh://git@git.example.com:4022/a/b.git"
@@ -12,17 +12,22 @@ Feature: too few or many parameters
       | CMD                          | ERROR                                              |
       | append                       | accepts 1 arg(s), received 0                       |
       | append arg1 arg2             | accepts 1 arg(s), received 2                       |
+      | branch arg1                  | unknown command "arg1" for "git-town branch"       |
       | completions arg1             | unknown completion type: "arg1"                    |
+      | compress arg1                | unknown command "arg1" for "git-town compress"     |
       | config arg1                  | unknown command "arg1" for "git-town config"       |
       | config setup arg1            | unknown command "arg1" for "git-town config setup" |
-      | kill arg1 arg2               | accepts at most 1 arg(s), received 2               |
+      | detach arg1                  | unknown command "arg1" for "git-town detach"       |
+      | merge arg1                   | unknown command "arg1" for "git-town merge"        |
       | offline arg1 arg2            | accepts at most 1 arg(s), received 2               |
       | propose arg1                 | unknown command "arg1" for "git-town propose"      |
       | prepend                      | accepts 1 arg(s), received 0                       |
+      | prune arg1                   | unknown command "arg1" for "git-town prune"        |
       | rename-branch                | accepts between 1 and 2 arg(s), received 0         |
       | rename-branch arg1 arg2 arg3 | accepts between 1 and 2 arg(s), received 3         |
       | repo arg1                    | unknown command "arg1" for "git-town repo"         |
       | set-parent arg1              | unknown command "arg1" for "git-town set-parent"   |
       | ship arg1 arg2               | accepts at most 1 arg(s), received 2               |
-      | sync arg1                    | unknown command "arg1" for "git-town sync"         |
+      | swap arg1                    | unknown command "arg1" for "git-town swap"         |
+      | walk --stack                 | requires at least 1 arg(s), only received 0        |
       | --version arg1               | unknown command "arg1" for "git-town"              |
@@ -0,0 +1,45 @@
+Feature: ship a branch by fast-forwarding the main branch
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And Git Town setting "ship-strategy" is "fast-forward"
+    When I run "git-town ship"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                     |
+      | feature | git fetch --prune --tags    |
+      |         | git checkout main           |
+      | main    | git merge --ff-only feature |
+      |         | git push                    |
+      |         | git push origin :feature    |
+      |         | git branch -D feature       |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE        |
+      | main   | local, origin | feature commit |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git checkout feature                          |
+    And it prints:
+      """
+      cannot undo the fast-forward of branch "main", please revert the shipped commits on it manually
+      """
+    And the current branch is now "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | main    | local, origin | feature commit |
+      | feature | local, origin | feature commit |
+    And the initial branches and lineage exist
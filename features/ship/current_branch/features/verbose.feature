@@ -67,6 +67,7 @@ Feature: display all executed Git commands
       |        | backend  | git rev-parse --verify --abbrev-ref @{-1}      |
       |        | backend  | git remote get-url origin                      |
       |        | backend  | git log --pretty=format:%h -10                 |
+      |        | backend  | git rev-list --parents -n 1 {{ sha 'done' }}   |
       | main   | frontend | git revert {{ sha 'done' }}                    |
       |        | backend  | git rev-list --left-right main...origin/main   |
       | main   | frontend | git push                                       |
@@ -75,8 +76,12 @@ Feature: display all executed Git commands
       |        | backend  | git show-ref --quiet refs/heads/feature        |
       | main   | frontend | git checkout feature                           |
       |        | backend  | git config git-town-branch.feature.parent main |
+      |        | backend  | git branch -vva                                |
+      |        | backend  | git config -lz --global                        |
+      |        | backend  | git config -lz --local                         |
+      |        | backend  | git stash list                                 |
     And it prints:
       """
-      Ran 18 shell commands.
+      Ran 23 shell commands.
       """
     And the current branch is now "feature"
@@ -0,0 +1,13 @@
+Feature: does not ship a stack with a commit message
+
+  Scenario:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    When I run "git-town ship --stack -m done"
+    Then it runs no commands
+    And it prints the error:
+      """
+      the --message flag cannot be used with --stack because each shipped branch needs its own commit message
+      """
+
@@ -0,0 +1,62 @@
+@skipWindows
+Feature: handle conflicts while shipping a stack
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE                 | FILE NAME        | FILE CONTENT |
+      | main   | local    | conflicting main commit | conflicting_file | main content |
+      | alpha  | local    | alpha commit            | alpha_file       | alpha        |
+      | beta   | local    | conflicting beta commit | conflicting_file | beta content |
+    And the current branch is "beta"
+    When I run "git-town ship --stack" and enter "done" for the commit message
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                         |
+      | beta   | git fetch --prune --tags        |
+      |        | git checkout main               |
+      | main   | git merge --squash alpha        |
+      |        | git commit                      |
+      |        | git push                        |
+      |        | git push origin :alpha          |
+      |        | git branch -D alpha             |
+      |        | git checkout beta               |
+      | beta   | git merge --no-edit origin/beta |
+      |        | git merge --no-edit main        |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      """
+    And the current branch is now "beta"
+    And a merge is now in progress
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file" with "resolved content"
+    And I run "git-town continue" and enter "done" for the commit message
+    Then it runs the commands
+      | BRANCH | COMMAND                 |
+      | beta   | git commit --no-edit    |
+      |        | git checkout main       |
+      | main   | git merge --squash beta |
+      |        | git commit              |
+      |        | git push                |
+      |        | git push origin :beta   |
+      |        | git branch -D beta      |
+    And the current branch is now "main"
+    And no merge is in progress
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then the current branch is now "beta"
+    And no merge is in progress
+    And the initial branches and lineage exist
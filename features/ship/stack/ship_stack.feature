@@ -0,0 +1,61 @@
+@skipWindows
+Feature: ship an entire stack
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT |
+      | alpha  | local, origin | alpha commit | alpha_file | alpha        |
+      | beta   | local, origin | beta commit  | beta_file  | beta         |
+      | gamma  | local, origin | gamma commit | gamma_file | gamma        |
+    And the current branch is "beta"
+    When I run "git-town ship --stack" and enter "done" for the commit message
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                         |
+      | beta   | git fetch --prune --tags        |
+      |        | git checkout main               |
+      | main   | git merge --squash alpha        |
+      |        | git commit                      |
+      |        | git push                        |
+      |        | git push origin :alpha          |
+      |        | git branch -D alpha             |
+      |        | git checkout beta               |
+      | beta   | git merge --no-edit origin/beta |
+      |        | git merge --no-edit main        |
+      |        | git checkout main               |
+      | main   | git merge --squash beta         |
+      |        | git commit                      |
+      |        | git push                        |
+      |        | git branch -D beta              |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY | BRANCHES          |
+      | local      | main, gamma       |
+      | origin     | main, beta, gamma |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | done         |
+      |        |               | done         |
+      | beta   | origin        | beta commit  |
+      | gamma  | local, origin | gamma commit |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | gamma  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then the current branch is now "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | main   | local, origin | done          |
+      |        |               | done          |
+      |        |               | Revert "done" |
+      |        |               | Revert "done" |
+      | alpha  | local, origin | alpha commit  |
+      | beta   | local, origin | beta commit   |
+      | gamma  | local, origin | gamma commit  |
+    And the initial branches and lineage exist
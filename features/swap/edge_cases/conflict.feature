@@ -0,0 +1,68 @@
+Feature: swap branches whose changes conflict
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME        | FILE CONTENT  |
+      | alpha  | local, origin | alpha 1 | conflicting_file | alpha content |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME        | FILE CONTENT |
+      | beta   | local, origin | beta 1  | conflicting_file | beta content |
+    And the current branch is "beta"
+    When I run "git-town swap"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                      |
+      | beta   | git fetch --prune --tags     |
+      |        | git rebase --onto main alpha |
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      """
+    And a rebase is now in progress
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git rebase --abort |
+    And the current branch is still "beta"
+    And no rebase is in progress
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME        | FILE CONTENT  |
+      | alpha  | local, origin | alpha 1 | conflicting_file | alpha content |
+      | beta   | local, origin | alpha 1 | conflicting_file | alpha content |
+      |        |               | beta 1  | conflicting_file | beta content  |
+    And the initial branches and lineage exist
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file" with "beta content"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git rebase --continue                           |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout alpha                              |
+      | alpha  | git rebase --onto beta main                     |
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      """
+    And a rebase is now in progress
+    When I resolve the conflict in "conflicting_file" with "alpha content"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | alpha  | git rebase --continue                           |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+    And the current branch is still "beta"
+    And no rebase is in progress
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | beta   |
+      | beta   | main   |
@@ -0,0 +1,54 @@
+Feature: does not swap branches that cannot be swapped
+
+  Scenario: parent is the main branch
+    Given the current branch is a feature branch "feature"
+    When I run "git-town swap"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot swap main branch "main"
+      """
+
+  Scenario: parent is a perennial branch
+    Given a perennial branch "production"
+    And a feature branch "feature" as a child of "production"
+    And the current branch is "feature"
+    When I run "git-town swap"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot swap perennial branch "production"
+      """
+
+  Scenario: current branch is not in sync with its parent
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+      | beta   | local, origin | beta 1  |
+    And the current branch is "beta"
+    When I run "git-town swap"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "beta" is not in sync, please run "git town sync" first
+      """
+
+  Scenario: uncommitted changes
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    And an uncommitted file
+    When I run "git-town swap"
+    Then it runs no commands
+    And it prints the error:
+      """
+      you have uncommitted changes. Did you mean to commit them before shipping?
+      """
@@ -0,0 +1,66 @@
+Feature: swap the current branch with its parent
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | alpha  | local, origin | alpha 1 | alpha_1   | alpha 1      |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | beta   | local, origin | beta 1  | beta_1    | beta 1       |
+    And a feature branch "gamma" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | gamma  | local, origin | gamma 1 | gamma_1   | gamma 1      |
+    And the current branch is "beta"
+    When I run "git-town swap"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git fetch --prune --tags                        |
+      |        | git rebase --onto main alpha                    |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout alpha                              |
+      | alpha  | git rebase --onto beta main                     |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git rebase --onto alpha {{ sha-before-run 'beta 1' }} |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+    And it prints:
+      """
+      branch "alpha" is now a child of "beta"
+      """
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | beta 1  |
+      |        |               | alpha 1 |
+      | beta   | local, origin | beta 1  |
+      | gamma  | local, origin | beta 1  |
+      |        |               | alpha 1 |
+      |        |               | gamma 1 |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | beta   |
+      | beta   | main   |
+      | gamma  | alpha  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git checkout alpha                              |
+      | alpha  | git reset --hard {{ sha-before-run 'alpha 1' }} |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+      | beta   | git reset --hard {{ sha-before-run 'beta 1' }}  |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --hard {{ sha-before-run 'gamma 1' }} |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+    And the current branch is still "beta"
+    And the initial branches and lineage exist
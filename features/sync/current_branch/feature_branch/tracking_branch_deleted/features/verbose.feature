@@ -67,9 +67,13 @@ Feature: display all executed Git commands
       |        | backend  | git show-ref --quiet refs/heads/old        |
       | main   | frontend | git checkout old                           |
       |        | backend  | git config git-town-branch.old.parent main |
+      |        | backend  | git branch -vva                            |
+      |        | backend  | git config -lz --global                    |
+      |        | backend  | git config -lz --local                     |
+      |        | backend  | git stash list                             |
     And it prints:
       """
-      Ran 13 shell commands.
+      Ran 17 shell commands.
       """
     And the current branch is now "old"
     And the initial branches and lineage exist
@@ -0,0 +1,37 @@
+Feature: sync a prototype branch
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "merge"
+    And the current branch is a local prototype branch "prototype"
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE                |
+      | main      | origin   | origin main commit     |
+      | prototype | local    | local prototype commit |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH    | COMMAND                  |
+      | prototype | git fetch --prune --tags |
+      |           | git checkout main        |
+      | main      | git rebase origin/main   |
+      |           | git checkout prototype   |
+      | prototype | git rebase main          |
+    And the current branch is still "prototype"
+    And these commits exist now
+      | BRANCH    | LOCATION      | MESSAGE                |
+      | main      | local, origin | origin main commit     |
+      | prototype | local         | origin main commit     |
+      |           |               | local prototype commit |
+    And branch "prototype" is still a prototype branch
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH    | COMMAND                                                        |
+      | prototype | git checkout main                                              |
+      | main      | git reset --hard {{ sha 'initial commit' }}                    |
+      |           | git checkout prototype                                         |
+      | prototype | git reset --hard {{ sha-before-run 'local prototype commit' }} |
+    And the current branch is still "prototype"
+    And the initial branches and lineage exist
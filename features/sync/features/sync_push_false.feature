@@ -0,0 +1,53 @@
+Feature: disable pushing through Git metadata
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the local feature branch "local"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE               |
+      | main    | origin   | origin main commit    |
+      | feature | local    | local feature commit  |
+      |         | origin   | origin feature commit |
+      | local   | local    | local branch commit   |
+    And the current branch is "feature"
+    And Git Town setting "sync-push" is "false"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      |         | git checkout main                  |
+      | main    | git rebase origin/main             |
+      |         | git checkout feature               |
+      | feature | git merge --no-edit origin/feature |
+      |         | git merge --no-edit main           |
+      |         | git checkout local                 |
+      | local   | git merge --no-edit main           |
+      |         | git checkout feature               |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE                                                    |
+      | main    | local, origin | origin main commit                                         |
+      | feature | local         | local feature commit                                       |
+      |         | local, origin | origin feature commit                                      |
+      |         | local         | Merge remote-tracking branch 'origin/feature' into feature |
+      |         |               | origin main commit                                         |
+      |         |               | Merge branch 'main' into feature                           |
+      | local   | local         | local branch commit                                        |
+      |         |               | origin main commit                                         |
+      |         |               | Merge branch 'main' into local                             |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                           |
+      | feature | git reset --hard {{ sha 'local feature commit' }} |
+      |         | git checkout local                                |
+      | local   | git reset --hard {{ sha 'local branch commit' }}  |
+      |         | git checkout main                                 |
+      | main    | git reset --hard {{ sha 'initial commit' }}       |
+      |         | git checkout feature                              |
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,13 @@
+Feature: does not allow syncing all branches and the given branches at the same time
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    When I run "git-town sync --all feature"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      the --all flag cannot be used together with branch names
+      """
+    And the current branch is still "feature"
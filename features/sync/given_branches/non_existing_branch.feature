@@ -0,0 +1,15 @@
+Feature: sync a branch that doesn't exist
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    When I run "git-town sync zonk"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      there is no branch "zonk"
+      """
+    And the current branch is still "feature"
@@ -0,0 +1,72 @@
+Feature: sync the given branches
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "main"
+    And a feature branch "other" as a child of "main"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | origin        | main commit  |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+      | gamma  | local, origin | gamma commit |
+      | other  | local, origin | other commit |
+    And the current branch is "other"
+    When I run "git-town sync beta gamma"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | other  | git fetch --prune --tags         |
+      |        | git checkout main                |
+      | main   | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git push                         |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit origin/beta  |
+      |        | git merge --no-edit alpha        |
+      |        | git push                         |
+      |        | git checkout gamma               |
+      | gamma  | git merge --no-edit origin/gamma |
+      |        | git merge --no-edit main         |
+      |        | git push                         |
+      |        | git checkout other               |
+    And the current branch is still "other"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | main   | local, origin | main commit                    |
+      | alpha  | local, origin | alpha commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      | beta   | local, origin | beta commit                    |
+      |        |               | alpha commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      |        |               | Merge branch 'alpha' into beta |
+      | gamma  | local, origin | gamma commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into gamma |
+      | other  | local, origin | other commit                   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | other  | git checkout alpha                              |
+      | alpha  | git reset --hard {{ sha 'alpha commit' }}       |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+      | beta   | git reset --hard {{ sha 'beta commit' }}        |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --hard {{ sha 'gamma commit' }}       |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout main                               |
+      | main   | git reset --hard {{ sha 'initial commit' }}     |
+      |        | git checkout other                              |
+    And the current branch is still "other"
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,13 @@
+Feature: does not allow syncing all branches and the current stack at the same time
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    When I run "git-town sync --all --stack"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      the --all and --stack flags cannot be used together
+      """
+    And the current branch is still "feature"
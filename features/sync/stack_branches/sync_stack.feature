@@ -0,0 +1,76 @@
+Feature: sync the current stack
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And a feature branch "other" as a child of "main"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | origin        | main commit  |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+      | gamma  | local, origin | gamma commit |
+      | other  | local, origin | other commit |
+    And the current branch is "beta"
+    When I run "git-town sync --stack"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | beta   | git fetch --prune --tags         |
+      |        | git checkout main                |
+      | main   | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git push                         |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit origin/beta  |
+      |        | git merge --no-edit alpha        |
+      |        | git push                         |
+      |        | git checkout gamma               |
+      | gamma  | git merge --no-edit origin/gamma |
+      |        | git merge --no-edit beta         |
+      |        | git push                         |
+      |        | git checkout beta                |
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | main   | local, origin | main commit                    |
+      | alpha  | local, origin | alpha commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      | beta   | local, origin | beta commit                    |
+      |        |               | alpha commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      |        |               | Merge branch 'alpha' into beta |
+      | gamma  | local, origin | gamma commit                   |
+      |        |               | beta commit                    |
+      |        |               | alpha commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      |        |               | Merge branch 'alpha' into beta |
+      |        |               | Merge branch 'beta' into gamma |
+      | other  | local, origin | other commit                   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git checkout alpha                              |
+      | alpha  | git reset --hard {{ sha 'alpha commit' }}       |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+      | beta   | git reset --hard {{ sha 'beta commit' }}        |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git reset --hard {{ sha 'gamma commit' }}       |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout main                               |
+      | main   | git reset --hard {{ sha 'initial commit' }}     |
+      |        | git checkout beta                               |
+    And the current branch is still "beta"
+    And the initial commits exist
+    And the initial branches and lineage exist
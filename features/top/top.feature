@@ -0,0 +1,36 @@
+Feature: switch to the top of the stack
+
+  Scenario: linear stack
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And the current branch is "alpha"
+    When I run "git-town top"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | alpha  | git checkout gamma |
+    And the current branch is now "gamma"
+
+  Scenario: forked stack
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta-1" as a child of "alpha"
+    And a feature branch "beta-2" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta-2"
+    And the current branch is "alpha"
+    When I run "git-town top" and enter into the dialog:
+      | DIALOG                | KEYS       |
+      | child branch of alpha | down enter |
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | alpha  | git checkout gamma |
+    And the current branch is now "gamma"
+
+  Scenario: already at the top
+    Given the current branch is a feature branch "alpha"
+    When I run "git-town top"
+    Then it runs no commands
+    And it prints:
+      """
+      branch "alpha" is already at the top of its stack
+      """
+    And the current branch is still "alpha"
@@ -0,0 +1,20 @@
+Feature: undo after a dry run
+
+  Background:
+    Given offline mode is enabled
+    And I ran "git-town hack alpha"
+    And I ran "git-town hack beta --dry-run"
+
+  Scenario: undo the command before the dry run
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND             |
+      | alpha  | git checkout main   |
+      | main   | git branch -D alpha |
+    And the current branch is now "main"
+    And no lineage exists now
+    When I run "git-town undo"
+    Then it prints:
+      """
+      nothing to undo
+      """
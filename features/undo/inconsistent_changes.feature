@@ -0,0 +1,16 @@
+Feature: refuse to undo multiple commands when branches were changed outside of Git Town in between
+
+  Scenario:
+    Given offline mode is enabled
+    And I ran "git-town hack alpha"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE      |
+      | alpha  | local    | alpha commit |
+    And I ran "git-town hack beta"
+    When I run "git-town undo --steps 2"
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot undo 2 commands because these branches have been changed outside of Git Town in between: alpha
+      """
+    And the current branch is still "beta"
@@ -0,0 +1,49 @@
+Feature: undo multiple Git Town commands
+
+  Background:
+    Given offline mode is enabled
+    And I ran "git-town hack alpha"
+    And I ran "git-town hack beta"
+
+  Scenario: undo the most recent commands one at a time
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git checkout alpha |
+      | alpha  | git branch -D beta |
+    And the current branch is now "alpha"
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND             |
+      | alpha  | git checkout main   |
+      | main   | git branch -D alpha |
+    And the current branch is now "main"
+    And no lineage exists now
+    When I run "git-town undo"
+    Then it prints:
+      """
+      nothing to undo
+      """
+
+  Scenario: undo multiple commands at once
+    When I run "git-town undo --steps 2"
+    Then it runs the commands
+      | BRANCH | COMMAND             |
+      | beta   | git checkout alpha  |
+      | alpha  | git branch -D beta  |
+      |        | git checkout main   |
+      | main   | git branch -D alpha |
+    And the current branch is now "main"
+    And the initial branches and lineage exist
+
+  Scenario: undo more commands than the history contains
+    When I run "git-town undo --steps 3"
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot undo 3 commands because the history contains only 2
+      """
+    And the current branch is still "beta"
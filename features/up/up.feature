@@ -0,0 +1,47 @@
+Feature: switch to the child branch
+
+  Scenario: single child branch
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "alpha"
+    When I run "git-town up"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | alpha  | git checkout beta |
+    And the current branch is now "beta"
+
+  Scenario: multiple child branches
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta-1" as a child of "alpha"
+    And a feature branch "beta-2" as a child of "alpha"
+    And the current branch is "alpha"
+    When I run "git-town up" and enter into the dialog:
+      | DIALOG                | KEYS       |
+      | child branch of alpha | down enter |
+    Then it runs the commands
+      | BRANCH | COMMAND             |
+      | alpha  | git checkout beta-2 |
+    And the current branch is now "beta-2"
+
+  Scenario: no child branches
+    Given the current branch is a feature branch "alpha"
+    When I run "git-town up"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "alpha" has no child branches
+      """
+    And the current branch is still "alpha"
+
+  Scenario: uncommitted changes
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "alpha"
+    And an uncommitted file
+    When I run "git-town up"
+    Then it runs no commands
+    And it prints the error:
+      """
+      you have uncommitted changes. Did you mean to commit them before shipping?
+      """
+    And the current branch is still "alpha"
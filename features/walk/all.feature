@@ -0,0 +1,21 @@
+Feature: walk all local branches
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "other" as a child of "main"
+    And the current branch is "other"
+    When I run "git-town walk --all git log --oneline"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | other  | git checkout main  |
+      | main   | git log --oneline  |
+      |        | git checkout alpha |
+      | alpha  | git log --oneline  |
+      |        | git checkout beta  |
+      | beta   | git log --oneline  |
+      |        | git checkout other |
+      | other  | git log --oneline  |
+    And the current branch is still "other"
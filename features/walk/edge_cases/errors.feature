@@ -0,0 +1,19 @@
+Feature: does not walk without knowing which branches to walk
+
+  Scenario: no branch selection
+    Given the current branch is a feature branch "feature"
+    When I run "git-town walk git status"
+    Then it runs no commands
+    And it prints the error:
+      """
+      please provide either --all or --stack to select the branches to walk
+      """
+
+  Scenario: conflicting branch selections
+    Given the current branch is a feature branch "feature"
+    When I run "git-town walk --all --stack git status"
+    Then it runs no commands
+    And it prints the error:
+      """
+      please provide either --all or --stack to select the branches to walk
+      """
@@ -0,0 +1,54 @@
+Feature: the shell command fails on one of the branches
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  |
+      | alpha  | local, origin | alpha commit | alpha_file |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE     | FILE NAME |
+      | beta   | local, origin | beta commit | beta_file |
+    And the current branch is "beta"
+    When I run "git-town walk --stack ls beta_file"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git checkout alpha |
+      | <none> | ls beta_file       |
+    And it prints the error:
+      """
+      command "ls beta_file" failed: exit status 2
+      """
+    And it prints the error:
+      """
+      To continue by skipping the current branch, run "git town skip".
+      """
+    And the current branch is now "alpha"
+
+  Scenario: continue runs the command again
+    When I run "git-town continue"
+    Then it runs the commands
+      | BRANCH | COMMAND      |
+      |        | ls beta_file |
+    And it prints the error:
+      """
+      command "ls beta_file" failed: exit status 2
+      """
+    And the current branch is still "alpha"
+
+  Scenario: skip the failing branch
+    When I run "git-town skip"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | alpha  | git checkout beta |
+      | <none> | ls beta_file      |
+    And the current branch is now "beta"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | alpha  | git checkout beta |
+    And the current branch is now "beta"
@@ -0,0 +1,26 @@
+Feature: walk the branches of the current stack
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And a feature branch "other" as a child of "main"
+    And the current branch is "beta"
+    When I run "git-town walk --stack git log --oneline"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND              |
+      | beta   | git checkout alpha   |
+      | alpha  | git log --oneline    |
+      |        | git checkout beta    |
+      | beta   | git log --oneline    |
+      |        | git checkout gamma   |
+      | gamma  | git log --oneline    |
+      |        | git checkout beta    |
+    And the current branch is still "beta"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "beta"
@@ -0,0 +1,34 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	bitbucketDatacenterTokenTitle = `Bitbucket Data Center API token`
+	bitbucketDatacenterTokenHelp  = `
+If you have a personal access token for Bitbucket Data Center,
+and want to create and ship pull requests from the CLI,
+please enter it now.
+
+It's okay to leave this empty.
+
+`
+)
+
+// BitbucketDatacenterToken lets the user enter the Bitbucket Data Center API token.
+func BitbucketDatacenterToken(oldValue configdomain.BitbucketDatacenterToken, inputs components.TestInput) (configdomain.BitbucketDatacenterToken, bool, error) {
+	token, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          bitbucketDatacenterTokenHelp,
+		Prompt:        "Your Bitbucket Data Center API token: ",
+		TestInput:     inputs,
+		Title:         bitbucketDatacenterTokenTitle,
+	})
+	fmt.Printf(messages.BitbucketDatacenterToken, components.FormattedSecret(token, aborted))
+	return configdomain.BitbucketDatacenterToken(token), aborted, err
+}
@@ -0,0 +1,34 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	bitbucketUsernameTitle = `Bitbucket username`
+	bitbucketUsernameHelp  = `
+If you want to create and ship pull requests
+on Bitbucket from the CLI, please enter
+your Bitbucket username now.
+
+It's okay to leave this empty.
+
+`
+)
+
+// BitbucketUsername lets the user enter the Bitbucket username.
+func BitbucketUsername(oldValue configdomain.BitbucketUsername, inputs components.TestInput) (configdomain.BitbucketUsername, bool, error) {
+	username, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          bitbucketUsernameHelp,
+		Prompt:        "Your Bitbucket username: ",
+		TestInput:     inputs,
+		Title:         bitbucketUsernameTitle,
+	})
+	fmt.Printf(messages.BitbucketUsername, components.FormattedToken(username, aborted))
+	return configdomain.BitbucketUsername(username), aborted, err
+}
@@ -0,0 +1,28 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	childBranchTitleTemplate = `Child branch of %s`
+	childBranchHelpTemplate  = `
+Branch %q has several child branches.
+Please select the one to switch to or enter its number.
+
+
+`
+)
+
+// ChildBranch lets the user select one of the given child branches of the given branch.
+func ChildBranch(branch gitdomain.LocalBranchName, children gitdomain.LocalBranchNames, dialogTestInput components.TestInput) (gitdomain.LocalBranchName, bool, error) {
+	title := fmt.Sprintf(childBranchTitleTemplate, branch)
+	help := fmt.Sprintf(childBranchHelpTemplate, branch)
+	selection, aborted, err := components.RadioList(children, 0, title, help, dialogTestInput)
+	fmt.Printf(messages.ChildBranchDialogSelected, branch, components.FormattedSelection(selection.String(), aborted))
+	return selection, aborted, err
+}
@@ -0,0 +1,181 @@
+package components
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
+	"github.com/muesli/termenv"
+)
+
+// CheckList lets the user select any number of the given entries.
+// The entries at the given positions start out checked.
+func CheckList[S fmt.Stringer](entries []S, selections []int, title, help string, inputs TestInput) (selected []S, aborted bool, err error) {
+	program := tea.NewProgram(CheckListModel[S]{
+		BubbleList:    NewBubbleList(entries, 0),
+		Selections:    selections,
+		help:          help,
+		selectedColor: termenv.String().Foreground(termenv.ANSIGreen),
+		title:         title,
+	})
+	SendInputs(inputs, program)
+	dialogResult, err := program.Run()
+	if err != nil {
+		return []S{}, false, err
+	}
+	result := dialogResult.(CheckListModel[S]) //nolint:forcetypeassert
+	return result.CheckedEntries(), result.Aborted(), nil
+}
+
+type CheckListModel[S fmt.Stringer] struct {
+	BubbleList[S]
+	Selections    []int         // positions of the checked entries
+	help          string        // help text to display before the check list
+	selectedColor termenv.Style // style for checked entries
+	title         string        // title to display before the help text
+}
+
+// CheckedEntries provides all checked list entries.
+func (self *CheckListModel[S]) CheckedEntries() []S {
+	result := []S{}
+	for e, entry := range self.Entries {
+		if self.IsRowChecked(e) {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// DisableCurrentEntry unchecks the currently selected list entry.
+func (self *CheckListModel[S]) DisableCurrentEntry() {
+	self.Selections = slice.Remove(self.Selections, self.Cursor)
+}
+
+// EnableCurrentEntry checks the currently selected list entry.
+func (self *CheckListModel[S]) EnableCurrentEntry() {
+	self.Selections = slice.AppendAllMissing(self.Selections, self.Cursor)
+}
+
+func (self CheckListModel[S]) Init() tea.Cmd {
+	return nil
+}
+
+// IsRowChecked indicates whether the row with the given number is checked or not.
+func (self *CheckListModel[S]) IsRowChecked(row int) bool {
+	return slices.Contains(self.Selections, row)
+}
+
+// IsSelectedRowChecked indicates whether the currently selected list entry is checked or not.
+func (self *CheckListModel[S]) IsSelectedRowChecked() bool {
+	return self.IsRowChecked(self.Cursor)
+}
+
+// ToggleCurrentEntry unchecks the currently selected list entry if it is checked,
+// and checks it if it is unchecked.
+func (self *CheckListModel[S]) ToggleCurrentEntry() {
+	if self.IsRowChecked(self.Cursor) {
+		self.DisableCurrentEntry()
+	} else {
+		self.EnableCurrentEntry()
+	}
+}
+
+func (self CheckListModel[S]) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolint:ireturn
+	keyMsg, isKeyMsg := msg.(tea.KeyMsg)
+	if !isKeyMsg {
+		return self, nil
+	}
+	if handled, cmd := self.BubbleList.HandleKey(keyMsg); handled {
+		return self, cmd
+	}
+	switch keyMsg.Type { //nolint:exhaustive
+	case tea.KeySpace:
+		self.ToggleCurrentEntry()
+		return self, nil
+	case tea.KeyEnter:
+		self.Status = StatusDone
+		return self, tea.Quit
+	}
+	if keyMsg.String() == "o" {
+		self.ToggleCurrentEntry()
+		return self, nil
+	}
+	return self, nil
+}
+
+func (self CheckListModel[S]) View() string {
+	if self.Status != StatusActive {
+		return ""
+	}
+	s := strings.Builder{}
+	s.WriteRune('\n')
+	s.WriteString(self.Colors.Title.Styled(self.title))
+	s.WriteRune('\n')
+	s.WriteString(self.help)
+	window := slice.Window(slice.WindowArgs{
+		CursorPos:    self.Cursor,
+		ElementCount: len(self.Entries),
+		WindowSize:   WindowSize,
+	})
+	for i := window.StartRow; i < window.EndRow; i++ {
+		entry := self.Entries[i]
+		selected := self.Cursor == i
+		checked := self.IsRowChecked(i)
+		s.WriteString(self.EntryNumberStr(i))
+		switch {
+		case selected && checked:
+			s.WriteString(self.Colors.Selection.Styled("> [x] " + entry.String()))
+		case selected && !checked:
+			s.WriteString(self.Colors.Selection.Styled("> [ ] " + entry.String()))
+		case !selected && checked:
+			s.WriteString(self.selectedColor.Styled("  [x] " + entry.String()))
+		case !selected && !checked:
+			s.WriteString("  [ ] " + entry.String())
+		}
+		s.WriteRune('\n')
+	}
+	s.WriteString("\n\n  ")
+	// up
+	s.WriteString(self.Colors.HelpKey.Styled("↑"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("k"))
+	s.WriteString(self.Colors.Help.Styled(" up   "))
+	// down
+	s.WriteString(self.Colors.HelpKey.Styled("↓"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("j"))
+	s.WriteString(self.Colors.Help.Styled(" down   "))
+	// left
+	s.WriteString(self.Colors.HelpKey.Styled("←"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("u"))
+	s.WriteString(self.Colors.Help.Styled(" 10 up   "))
+	// right
+	s.WriteString(self.Colors.HelpKey.Styled("→"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("d"))
+	s.WriteString(self.Colors.Help.Styled(" 10 down   "))
+	// toggle
+	s.WriteString(self.Colors.HelpKey.Styled("space"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("o"))
+	s.WriteString(self.Colors.Help.Styled(" toggle   "))
+	// numbers
+	s.WriteString(self.Colors.HelpKey.Styled("0"))
+	s.WriteString(self.Colors.Help.Styled("-"))
+	s.WriteString(self.Colors.HelpKey.Styled("9"))
+	s.WriteString(self.Colors.Help.Styled(" jump   "))
+	// accept
+	s.WriteString(self.Colors.HelpKey.Styled("enter"))
+	s.WriteString(self.Colors.Help.Styled(" accept   "))
+	// abort
+	s.WriteString(self.Colors.HelpKey.Styled("q"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("esc"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("ctrl-c"))
+	s.WriteString(self.Colors.Help.Styled(" abort"))
+	return s.String()
+}
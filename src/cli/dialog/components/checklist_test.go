@@ -1,22 +1,22 @@
-package dialog_test
+package components_test
 
 import (
 	"testing"
 
-	"github.com/git-town/git-town/v12/src/cli/dialog"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/shoenig/test/must"
 )
 
-func TestPerennialBranches(t *testing.T) {
+func TestCheckList(t *testing.T) {
 	t.Parallel()
 
-	t.Run("disableCurrentEntry", func(t *testing.T) {
+	t.Run("DisableCurrentEntry", func(t *testing.T) {
 		t.Parallel()
 		t.Run("entry is enabled", func(t *testing.T) {
 			t.Parallel()
-			model := dialog.PerennialBranchesModel{
+			model := components.CheckListModel[gitdomain.LocalBranchName]{
 				BubbleList: components.BubbleList[gitdomain.LocalBranchName]{ //nolint:exhaustruct
 					Cursor: 2,
 				},
@@ -28,7 +28,7 @@ func TestPerennialBranches(t *testing.T) {
 		})
 		t.Run("entry is disabled", func(t *testing.T) {
 			t.Parallel()
-			model := dialog.PerennialBranchesModel{
+			model := components.CheckListModel[gitdomain.LocalBranchName]{
 				BubbleList: components.BubbleList[gitdomain.LocalBranchName]{ //nolint:exhaustruct
 					Cursor: 2,
 				},
@@ -40,11 +40,11 @@ func TestPerennialBranches(t *testing.T) {
 		})
 	})
 
-	t.Run("enableCurrentEntry", func(t *testing.T) {
+	t.Run("EnableCurrentEntry", func(t *testing.T) {
 		t.Parallel()
 		t.Run("entry is disabled", func(t *testing.T) {
 			t.Parallel()
-			model := dialog.PerennialBranchesModel{
+			model := components.CheckListModel[gitdomain.LocalBranchName]{
 				BubbleList: components.BubbleList[gitdomain.LocalBranchName]{ //nolint:exhaustruct
 					Cursor: 2,
 				},
@@ -56,7 +56,7 @@ func TestPerennialBranches(t *testing.T) {
 		})
 		t.Run("entry is enabled", func(t *testing.T) {
 			t.Parallel()
-			model := dialog.PerennialBranchesModel{
+			model := components.CheckListModel[gitdomain.LocalBranchName]{
 				BubbleList: components.BubbleList[gitdomain.LocalBranchName]{ //nolint:exhaustruct
 					Cursor: 2,
 				},
@@ -68,11 +68,11 @@ func TestPerennialBranches(t *testing.T) {
 		})
 	})
 
-	t.Run("isSelectedRowChecked", func(t *testing.T) {
+	t.Run("IsSelectedRowChecked", func(t *testing.T) {
 		t.Parallel()
 		t.Run("selected row is checked", func(t *testing.T) {
 			t.Parallel()
-			model := dialog.PerennialBranchesModel{
+			model := components.CheckListModel[gitdomain.LocalBranchName]{
 				BubbleList: components.BubbleList[gitdomain.LocalBranchName]{ //nolint:exhaustruct
 					Cursor: 2,
 				},
@@ -82,7 +82,7 @@ func TestPerennialBranches(t *testing.T) {
 		})
 		t.Run("selected row is not checked", func(t *testing.T) {
 			t.Parallel()
-			model := dialog.PerennialBranchesModel{
+			model := components.CheckListModel[gitdomain.LocalBranchName]{
 				BubbleList: components.BubbleList[gitdomain.LocalBranchName]{ //nolint:exhaustruct
 					Cursor: 1,
 				},
@@ -92,9 +92,9 @@ func TestPerennialBranches(t *testing.T) {
 		})
 	})
 
-	t.Run("isRowChecked", func(t *testing.T) {
+	t.Run("IsRowChecked", func(t *testing.T) {
 		t.Parallel()
-		model := dialog.PerennialBranchesModel{ //nolint:exhaustruct
+		model := components.CheckListModel[gitdomain.LocalBranchName]{ //nolint:exhaustruct
 			Selections: []int{2},
 		}
 		must.False(t, model.IsRowChecked(1))
@@ -102,9 +102,9 @@ func TestPerennialBranches(t *testing.T) {
 		must.False(t, model.IsRowChecked(3))
 	})
 
-	t.Run("checkedEntries", func(t *testing.T) {
+	t.Run("CheckedEntries", func(t *testing.T) {
 		t.Parallel()
-		model := dialog.PerennialBranchesModel{
+		model := components.CheckListModel[gitdomain.LocalBranchName]{
 			BubbleList: components.BubbleList[gitdomain.LocalBranchName]{ //nolint:exhaustruct
 				Entries: gitdomain.NewLocalBranchNames("zero", "one", "two", "three"),
 			},
@@ -115,9 +115,9 @@ func TestPerennialBranches(t *testing.T) {
 		must.Eq(t, want, have)
 	})
 
-	t.Run("toggleCurrentEntry", func(t *testing.T) {
+	t.Run("ToggleCurrentEntry", func(t *testing.T) {
 		t.Parallel()
-		model := dialog.PerennialBranchesModel{
+		model := components.CheckListModel[gitdomain.LocalBranchName]{
 			BubbleList: components.BubbleList[gitdomain.LocalBranchName]{ //nolint:exhaustruct
 				Cursor: 2,
 			},
@@ -132,4 +132,42 @@ func TestPerennialBranches(t *testing.T) {
 		wantSelections = []int{1, 3}
 		must.Eq(t, wantSelections, model.Selections)
 	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+		model := components.CheckListModel[gitdomain.LocalBranchName]{ //nolint:exhaustruct
+			BubbleList: components.NewBubbleList(gitdomain.NewLocalBranchNames("zero", "one", "two"), 0),
+			Selections: []int{0},
+		}
+		keys := []tea.KeyMsg{
+			{Type: tea.KeyDown},                      //nolint:exhaustruct
+			{Type: tea.KeySpace},                     //nolint:exhaustruct
+			{Type: tea.KeyUp},                        //nolint:exhaustruct
+			{Type: tea.KeyRunes, Runes: []rune{'o'}}, //nolint:exhaustruct
+		}
+		for _, key := range keys {
+			updated, _ := model.Update(key)
+			model = updated.(components.CheckListModel[gitdomain.LocalBranchName]) //nolint:forcetypeassert
+		}
+		must.Eq(t, gitdomain.NewLocalBranchNames("one"), gitdomain.LocalBranchNames(model.CheckedEntries()))
+		must.EqOp(t, components.StatusActive, model.Status)
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})             //nolint:exhaustruct
+		model = updated.(components.CheckListModel[gitdomain.LocalBranchName]) //nolint:forcetypeassert
+		must.EqOp(t, components.StatusDone, model.Status)
+		must.False(t, model.Aborted())
+	})
+
+	t.Run("View", func(t *testing.T) {
+		t.Parallel()
+		model := components.CheckListModel[gitdomain.LocalBranchName]{ //nolint:exhaustruct
+			BubbleList: components.NewBubbleList(gitdomain.NewLocalBranchNames("zero", "one", "two"), 1),
+			Selections: []int{1, 2},
+		}
+		view := model.View()
+		must.StrContains(t, view, "  [ ] zero")
+		must.StrContains(t, view, "> [x] one")
+		must.StrContains(t, view, "  [x] two")
+		model.Status = components.StatusDone
+		must.EqOp(t, "", model.View())
+	})
 }
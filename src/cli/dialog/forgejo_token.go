@@ -0,0 +1,34 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	forgejoTokenTitle = `Forgejo API token`
+	forgejoTokenHelp  = `
+If you have an API token for Forgejo,
+and want to ship branches from the CLI,
+please enter it now.
+
+It's okay to leave this empty.
+
+`
+)
+
+// ForgejoToken lets the user enter the Forgejo API token.
+func ForgejoToken(oldValue configdomain.ForgejoToken, inputs components.TestInput) (configdomain.ForgejoToken, bool, error) {
+	token, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          forgejoTokenHelp,
+		Prompt:        "Your Forgejo API token: ",
+		TestInput:     inputs,
+		Title:         forgejoTokenTitle,
+	})
+	fmt.Printf(messages.ForgejoToken, components.FormattedSecret(token, aborted))
+	return configdomain.ForgejoToken(token), aborted, err
+}
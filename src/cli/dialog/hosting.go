@@ -23,7 +23,10 @@ Only change this if your code hosting server uses as custom URL.
 func HostingPlatform(existingValue configdomain.HostingPlatform, inputs components.TestInput) (configdomain.HostingPlatform, bool, error) {
 	entries := []hostingPlatformEntry{
 		hostingPlatformAutoDetect,
+		hostingPlatformAzureDevOps,
 		hostingPlatformBitBucket,
+		hostingPlatformBitbucketDatacenter,
+		hostingPlatformForgejo,
 		hostingPlatformGitea,
 		hostingPlatformGitHub,
 		hostingPlatformGitLab,
@@ -37,19 +40,28 @@ func HostingPlatform(existingValue configdomain.HostingPlatform, inputs componen
 type hostingPlatformEntry string
 
 const (
-	hostingPlatformAutoDetect hostingPlatformEntry = "auto-detect"
-	hostingPlatformBitBucket  hostingPlatformEntry = "BitBucket"
-	hostingPlatformGitea      hostingPlatformEntry = "Gitea"
-	hostingPlatformGitHub     hostingPlatformEntry = "Github"
-	hostingPlatformGitLab     hostingPlatformEntry = "GitLab"
+	hostingPlatformAutoDetect          hostingPlatformEntry = "auto-detect"
+	hostingPlatformAzureDevOps         hostingPlatformEntry = "Azure DevOps"
+	hostingPlatformBitBucket           hostingPlatformEntry = "BitBucket"
+	hostingPlatformBitbucketDatacenter hostingPlatformEntry = "Bitbucket Data Center"
+	hostingPlatformForgejo             hostingPlatformEntry = "Forgejo"
+	hostingPlatformGitea               hostingPlatformEntry = "Gitea"
+	hostingPlatformGitHub              hostingPlatformEntry = "Github"
+	hostingPlatformGitLab              hostingPlatformEntry = "GitLab"
 )
 
 func (self hostingPlatformEntry) HostingPlatform() configdomain.HostingPlatform {
 	switch self {
 	case hostingPlatformAutoDetect:
 		return configdomain.HostingPlatformNone
+	case hostingPlatformAzureDevOps:
+		return configdomain.HostingPlatformAzureDevOps
 	case hostingPlatformBitBucket:
 		return configdomain.HostingPlatformBitbucket
+	case hostingPlatformBitbucketDatacenter:
+		return configdomain.HostingPlatformBitbucketDatacenter
+	case hostingPlatformForgejo:
+		return configdomain.HostingPlatformForgejo
 	case hostingPlatformGitea:
 		return configdomain.HostingPlatformGitea
 	case hostingPlatformGitHub:
@@ -73,8 +85,14 @@ func newHostingPlatformEntry(hosting configdomain.HostingPlatform) hostingPlatfo
 	switch hosting {
 	case configdomain.HostingPlatformNone:
 		return hostingPlatformAutoDetect
+	case configdomain.HostingPlatformAzureDevOps:
+		return hostingPlatformAzureDevOps
 	case configdomain.HostingPlatformBitbucket:
 		return hostingPlatformBitBucket
+	case configdomain.HostingPlatformBitbucketDatacenter:
+		return hostingPlatformBitbucketDatacenter
+	case configdomain.HostingPlatformForgejo:
+		return hostingPlatformForgejo
 	case configdomain.HostingPlatformGitea:
 		return hostingPlatformGitea
 	case configdomain.HostingPlatformGitHub:
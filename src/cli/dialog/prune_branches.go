@@ -0,0 +1,40 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	pruneBranchesTitle = `Prune branches`
+	pruneBranchesHelp  = `
+The changes of these branches are already in the main branch,
+for example because they were squash-merged.
+Please select the branches to delete.
+
+`
+)
+
+// PruneBranches lets the user select which of the given branches to delete.
+func PruneBranches(candidates gitdomain.LocalBranchNames, inputs components.TestInput) (gitdomain.LocalBranchNames, bool, error) {
+	// all candidates start out checked
+	allEntries := make([]int, len(candidates))
+	for c := range candidates {
+		allEntries[c] = c
+	}
+	selected, aborted, err := components.CheckList(candidates, allEntries, pruneBranchesTitle, pruneBranchesHelp, inputs)
+	if err != nil {
+		return gitdomain.LocalBranchNames{}, false, err
+	}
+	selectedBranches := gitdomain.LocalBranchNames(selected)
+	selectionText := strings.Join(selectedBranches.Strings(), ", ")
+	if selectionText == "" {
+		selectionText = "(none)"
+	}
+	fmt.Printf(messages.PruneBranches, components.FormattedSelection(selectionText, aborted))
+	return selectedBranches, aborted, nil
+}
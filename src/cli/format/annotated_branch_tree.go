@@ -0,0 +1,44 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/muesli/termenv"
+)
+
+// AnnotatedBranchTreeEntry describes a single line in an annotated branch tree.
+type AnnotatedBranchTreeEntry struct {
+	Ahead      int // how many commits the branch is ahead of its tracking branch
+	Behind     int // how many commits the branch is behind its tracking branch
+	Branch     gitdomain.LocalBranchName
+	BranchType configdomain.BranchType
+	Current    bool // whether this is the currently checked out branch
+	Level      int  // how deep the branch is nested in the tree, starting at 0
+	SyncStatus gitdomain.SyncStatus
+}
+
+// AnnotatedBranchTree provides a printable version of the given branch tree entries,
+// annotated with the type and sync status of each branch.
+func AnnotatedBranchTree(entries []AnnotatedBranchTreeEntry) string {
+	result := strings.Builder{}
+	for _, entry := range entries {
+		details := []string{entry.BranchType.String(), entry.SyncStatus.String()}
+		if entry.Ahead > 0 {
+			details = append(details, fmt.Sprintf("%d ahead", entry.Ahead))
+		}
+		if entry.Behind > 0 {
+			details = append(details, fmt.Sprintf("%d behind", entry.Behind))
+		}
+		line := strings.Repeat("  ", entry.Level) + entry.Branch.String() + " (" + strings.Join(details, ", ") + ")"
+		if entry.Current {
+			result.WriteString(termenv.String("* " + line).Foreground(termenv.ANSIGreen).String())
+		} else {
+			result.WriteString("  " + line)
+		}
+		result.WriteString("\n")
+	}
+	return result.String()
+}
@@ -0,0 +1,125 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/format"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestAnnotatedBranchTree(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nested branches", func(t *testing.T) {
+		t.Parallel()
+		give := []format.AnnotatedBranchTreeEntry{
+			{
+				Ahead:      0,
+				Behind:     0,
+				Branch:     gitdomain.NewLocalBranchName("main"),
+				BranchType: configdomain.BranchTypeMainBranch,
+				Current:    false,
+				Level:      0,
+				SyncStatus: gitdomain.SyncStatusUpToDate,
+			},
+			{
+				Ahead:      0,
+				Behind:     0,
+				Branch:     gitdomain.NewLocalBranchName("alpha"),
+				BranchType: configdomain.BranchTypeFeatureBranch,
+				Current:    false,
+				Level:      1,
+				SyncStatus: gitdomain.SyncStatusLocalOnly,
+			},
+			{
+				Ahead:      0,
+				Behind:     0,
+				Branch:     gitdomain.NewLocalBranchName("beta"),
+				BranchType: configdomain.BranchTypeFeatureBranch,
+				Current:    false,
+				Level:      2,
+				SyncStatus: gitdomain.SyncStatusUpToDate,
+			},
+		}
+		have := format.AnnotatedBranchTree(give)
+		want := `  main (main branch, up to date)
+    alpha (feature branch, local only)
+      beta (feature branch, up to date)
+`
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("ahead and behind counts", func(t *testing.T) {
+		t.Parallel()
+		give := []format.AnnotatedBranchTreeEntry{
+			{
+				Ahead:      2,
+				Behind:     0,
+				Branch:     gitdomain.NewLocalBranchName("ahead"),
+				BranchType: configdomain.BranchTypeFeatureBranch,
+				Current:    false,
+				Level:      0,
+				SyncStatus: gitdomain.SyncStatusNotInSync,
+			},
+			{
+				Ahead:      0,
+				Behind:     3,
+				Branch:     gitdomain.NewLocalBranchName("behind"),
+				BranchType: configdomain.BranchTypeFeatureBranch,
+				Current:    false,
+				Level:      0,
+				SyncStatus: gitdomain.SyncStatusNotInSync,
+			},
+			{
+				Ahead:      1,
+				Behind:     4,
+				Branch:     gitdomain.NewLocalBranchName("both"),
+				BranchType: configdomain.BranchTypeFeatureBranch,
+				Current:    false,
+				Level:      0,
+				SyncStatus: gitdomain.SyncStatusNotInSync,
+			},
+		}
+		have := format.AnnotatedBranchTree(give)
+		want := `  ahead (feature branch, not in sync, 2 ahead)
+  behind (feature branch, not in sync, 3 behind)
+  both (feature branch, not in sync, 1 ahead, 4 behind)
+`
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("current branch", func(t *testing.T) {
+		t.Parallel()
+		give := []format.AnnotatedBranchTreeEntry{
+			{
+				Ahead:      0,
+				Behind:     0,
+				Branch:     gitdomain.NewLocalBranchName("main"),
+				BranchType: configdomain.BranchTypeMainBranch,
+				Current:    false,
+				Level:      0,
+				SyncStatus: gitdomain.SyncStatusUpToDate,
+			},
+			{
+				Ahead:      0,
+				Behind:     0,
+				Branch:     gitdomain.NewLocalBranchName("feature"),
+				BranchType: configdomain.BranchTypeFeatureBranch,
+				Current:    true,
+				Level:      1,
+				SyncStatus: gitdomain.SyncStatusUpToDate,
+			},
+		}
+		have := format.AnnotatedBranchTree(give)
+		must.StrContains(t, have, "  main (main branch, up to date)\n")
+		must.StrContains(t, have, "*   feature (feature branch, up to date)")
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		t.Parallel()
+		have := format.AnnotatedBranchTree([]format.AnnotatedBranchTreeEntry{})
+		must.EqOp(t, "", have)
+	})
+}
@@ -98,6 +98,7 @@ type appendConfig struct {
 	newBranchParentCandidates gitdomain.LocalBranchNames
 	parentBranch              gitdomain.LocalBranchName
 	previousBranch            gitdomain.LocalBranchName
+	prototype                 bool // whether to create the new branch as a prototype branch
 	remotes                   gitdomain.Remotes
 	targetBranch              gitdomain.LocalBranchName
 }
@@ -151,6 +152,7 @@ func determineAppendConfig(targetBranch gitdomain.LocalBranchName, repo *execute
 		newBranchParentCandidates: initialAndAncestors,
 		parentBranch:              branchesSnapshot.Active,
 		previousBranch:            previousBranch,
+		prototype:                 false,
 		remotes:                   remotes,
 		targetBranch:              targetBranch,
 	}, branchesSnapshot, stashSize, false, fc.Err
@@ -176,8 +178,11 @@ func appendProgram(config *appendConfig) program.Program {
 		Branch:    config.targetBranch,
 		Ancestors: config.newBranchParentCandidates,
 	})
+	if config.prototype {
+		prog.Add(&opcodes.AddToPrototypeBranches{Branch: config.targetBranch})
+	}
 	prog.Add(&opcodes.Checkout{Branch: config.targetBranch})
-	if config.remotes.HasOrigin() && config.ShouldPushNewBranches() && config.IsOnline() {
+	if config.remotes.HasOrigin() && config.ShouldPushNewBranches() && config.IsOnline() && !config.prototype {
 		prog.Add(&opcodes.CreateTrackingBranch{Branch: config.targetBranch})
 	}
 	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
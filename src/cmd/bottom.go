@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const bottomDesc = "Switches to the branch at the bottom of the current stack"
+
+const bottomHelp = `
+Switches to the oldest ancestor of the current branch that is not the main branch or a perennial branch.`
+
+func bottomCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "bottom",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   bottomDesc,
+		Long:    cmdhelpers.Long(bottomDesc, bottomHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeBottom(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeBottom(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineNavigateConfig(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	fullConfig := repo.Runner.Config.FullConfig
+	if fullConfig.IsMainOrPerennialBranch(config.initialBranch) {
+		return fmt.Errorf(messages.NavigateNoParent, config.initialBranch)
+	}
+	bottom := config.initialBranch
+	for _, ancestor := range fullConfig.Lineage.Ancestors(config.initialBranch) {
+		if !fullConfig.IsMainOrPerennialBranch(ancestor) {
+			bottom = ancestor
+			break
+		}
+	}
+	if bottom == config.initialBranch {
+		fmt.Printf(messages.NavigateAlreadyAtBottom, bottom)
+		return nil
+	}
+	return navigateTo(bottom, repo, config)
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cli/format"
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/spf13/cobra"
+)
+
+const branchDesc = "Displays the local branches as a tree"
+
+const branchHelp = `
+Prints all local branches as a tree that reflects the branch lineage.
+Each branch is annotated with its type and its sync status.
+Branches that are ahead or behind their tracking branch show how many commits they differ.
+The current branch is marked with an asterisk.`
+
+func branchCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "branch",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   branchDesc,
+		Long:    cmdhelpers.Long(branchDesc, branchHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeBranch(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeBranch(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineBranchConfig(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	entries, err := branchTreeEntries(config, repo)
+	if err != nil {
+		return err
+	}
+	fmt.Print(format.AnnotatedBranchTree(entries))
+	print.Footer(verbose, repo.Runner.CommandsCounter.Count(), repo.Runner.FinalMessages.Result())
+	return nil
+}
+
+type branchConfig struct {
+	*configdomain.FullConfig
+	branches      gitdomain.BranchInfos
+	currentBranch gitdomain.LocalBranchName
+}
+
+func determineBranchConfig(repo *execute.OpenRepoResult, verbose bool) (*branchConfig, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	branchesSnapshot, _, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 false,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: false,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	return &branchConfig{
+		FullConfig:    &repo.Runner.Config.FullConfig,
+		branches:      branchesSnapshot.Branches,
+		currentBranch: branchesSnapshot.Active,
+	}, false, nil
+}
+
+// branchTreeEntries provides the entries of the branch tree in display order.
+func branchTreeEntries(config *branchConfig, repo *execute.OpenRepoResult) ([]format.AnnotatedBranchTreeEntry, error) {
+	localBranches := config.branches.LocalBranches()
+	roots := config.Lineage.Roots()
+	for _, branch := range localBranches.Names() {
+		if !config.Lineage.HasParents(branch) && !roots.Contains(branch) {
+			roots = append(roots, branch)
+		}
+	}
+	roots.Sort()
+	roots = roots.Hoist(config.MainBranch)
+	result := []format.AnnotatedBranchTreeEntry{}
+	for _, root := range roots {
+		var err error
+		result, err = appendBranchTreeEntries(result, root, 0, config, repo)
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// appendBranchTreeEntries appends the entries for the given branch and its descendants to the given entries.
+// Branches that don't exist locally are omitted, their children take their place.
+func appendBranchTreeEntries(entries []format.AnnotatedBranchTreeEntry, branch gitdomain.LocalBranchName, level int, config *branchConfig, repo *execute.OpenRepoResult) ([]format.AnnotatedBranchTreeEntry, error) {
+	childLevel := level
+	branchInfo := config.branches.FindByLocalName(branch)
+	if branchInfo != nil && branchInfo.IsLocal() {
+		entry := format.AnnotatedBranchTreeEntry{
+			Ahead:      0,
+			Behind:     0,
+			Branch:     branch,
+			BranchType: config.BranchType(branch),
+			Current:    branch == config.currentBranch,
+			Level:      level,
+			SyncStatus: branchInfo.SyncStatus,
+		}
+		if branchInfo.HasTrackingBranch() && branchInfo.SyncStatus == gitdomain.SyncStatusNotInSync {
+			var err error
+			entry.Ahead, entry.Behind, err = repo.Runner.Backend.AheadBehindCounts(branch, branchInfo.RemoteName)
+			if err != nil {
+				return entries, err
+			}
+		}
+		entries = append(entries, entry)
+		childLevel = level + 1
+	}
+	for _, child := range config.Lineage.Children(branch) {
+		var err error
+		entries, err = appendBranchTreeEntries(entries, child, childLevel, config, repo)
+		if err != nil {
+			return entries, err
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const compressDesc = "Squashes all commits on a feature branch down to a single commit"
+
+const compressHelp = `
+Compress is a more convenient way of running "git rebase --interactive" and choosing to squash or fixup all commits.
+Branches must be in sync with their parent and tracking branch to compress them.
+
+By default the new commit uses the commit message of the first commit in the branch.
+You can provide a custom commit message with the -m switch.
+
+Assuming you have a feature branch "feature-1" with commits 1, 2, and 3,
+running "git town compress" creates a single commit that contains the changes of commits 1, 2, and 3
+and force-pushes it to the tracking branch.
+
+If you provide the --stack switch, compresses all feature branches in the current stack.`
+
+func compressCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addMessageFlag, readMessageFlag := flags.String("message", "m", "", "Customize the commit message")
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Compress the entire stack", flags.FlagTypeNonPersistent)
+	cmd := cobra.Command{
+		Use:     "compress",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   compressDesc,
+		Long:    cmdhelpers.Long(compressDesc, compressHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeCompress(readDryRunFlag(cmd), readVerboseFlag(cmd), readMessageFlag(cmd), readStackFlag(cmd))
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	addMessageFlag(&cmd)
+	addStackFlag(&cmd)
+	return &cmd
+}
+
+func executeCompress(dryRun, verbose bool, message string, stack bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineCompressConfig(repo, dryRun, verbose, message, stack)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "compress",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            compressProgram(config),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               nil,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          config.hasOpenChanges,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type compressConfig struct {
+	*configdomain.FullConfig
+	branchesToCompress []compressBranchConfig
+	dialogTestInputs   components.TestInputs
+	dryRun             bool
+	hasOpenChanges     bool
+	initialBranch      gitdomain.LocalBranchName
+	previousBranch     gitdomain.LocalBranchName
+}
+
+// compressBranchConfig describes how to compress a single branch.
+type compressBranchConfig struct {
+	hasTrackingBranch bool
+	message           string
+	name              gitdomain.LocalBranchName
+	parent            gitdomain.LocalBranchName
+}
+
+func determineCompressConfig(repo *execute.OpenRepoResult, dryRun, verbose bool, message string, stack bool) (*compressConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	initialBranch := branchesSnapshot.Active
+	err = execute.EnsureKnownBranchAncestry(initialBranch, execute.EnsureKnownBranchAncestryArgs{
+		Config:           &repo.Runner.Config.FullConfig,
+		AllBranches:      branchesSnapshot.Branches,
+		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+		DialogTestInputs: &dialogTestInputs,
+		Runner:           repo.Runner,
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	var branchNamesToCompress gitdomain.LocalBranchNames
+	if stack {
+		branchNamesToCompress = append(lineage.BranchAndAncestors(initialBranch), lineage.Descendants(initialBranch)...)
+	} else {
+		branchNamesToCompress = gitdomain.LocalBranchNames{initialBranch}
+	}
+	branchesToCompress := make([]compressBranchConfig, 0, len(branchNamesToCompress))
+	compressedBranches := gitdomain.LocalBranchNames{}
+	for _, branchNameToCompress := range branchNamesToCompress {
+		branchType := repo.Runner.Config.FullConfig.BranchType(branchNameToCompress)
+		if !branchType.IsRewritable() {
+			if stack {
+				continue
+			}
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.CompressBranchType, branchType, branchNameToCompress)
+		}
+		branchInfo := branchesSnapshot.Branches.FindByLocalName(branchNameToCompress)
+		if branchInfo == nil {
+			if stack {
+				continue
+			}
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToCompress)
+		}
+		switch branchInfo.SyncStatus {
+		case gitdomain.SyncStatusDeletedAtRemote, gitdomain.SyncStatusNotInSync, gitdomain.SyncStatusOtherWorktree, gitdomain.SyncStatusRemoteOnly:
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchNotInSync, branchNameToCompress)
+		case gitdomain.SyncStatusLocalOnly, gitdomain.SyncStatusUpToDate:
+		}
+		parent := lineage.Parent(branchNameToCompress)
+		if !repo.Runner.Backend.BranchInSyncWithParent(branchNameToCompress, parent) {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchNotInSync, branchNameToCompress)
+		}
+		commits, err := repo.Runner.Backend.CommitsInFeatureBranch(branchNameToCompress, parent)
+		if err != nil {
+			return nil, branchesSnapshot, stashSize, false, err
+		}
+		switch {
+		case len(commits) == 0 && stack:
+			continue
+		case len(commits) == 0:
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.CompressNoCommits, branchNameToCompress)
+		case len(commits) == 1 && message == "" && stack && !compressedBranches.Contains(parent):
+			// compressing would only rewrite the SHA of the existing commit,
+			// this is still necessary if the parent gets compressed because it moves the commit onto the new parent
+			continue
+		case len(commits) == 1 && message == "" && !stack:
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.CompressAlreadyOneCommit, branchNameToCompress)
+		}
+		commitMessage := message
+		if commitMessage == "" {
+			commitMessage, err = repo.Runner.Backend.CommitMessage(commits[0])
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, err
+			}
+		}
+		compressedBranches = append(compressedBranches, branchNameToCompress)
+		branchesToCompress = append(branchesToCompress, compressBranchConfig{
+			hasTrackingBranch: branchInfo.HasTrackingBranch(),
+			message:           commitMessage,
+			name:              branchNameToCompress,
+			parent:            parent,
+		})
+	}
+	return &compressConfig{
+		FullConfig:         &repo.Runner.Config.FullConfig,
+		branchesToCompress: branchesToCompress,
+		dialogTestInputs:   dialogTestInputs,
+		dryRun:             dryRun,
+		hasOpenChanges:     repoStatus.OpenChanges,
+		initialBranch:      initialBranch,
+		previousBranch:     repo.Runner.Backend.PreviouslyCheckedOutBranch(),
+	}, branchesSnapshot, stashSize, false, nil
+}
+
+func compressProgram(config *compressConfig) program.Program {
+	prog := program.Program{}
+	for _, branchToCompress := range config.branchesToCompress {
+		compressBranchProgram(&prog, branchToCompress, config.IsOnline())
+	}
+	prog.Add(&opcodes.Checkout{Branch: config.initialBranch})
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   config.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         config.hasOpenChanges,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
+	})
+	return prog
+}
+
+func compressBranchProgram(prog *program.Program, branch compressBranchConfig, online bool) {
+	prog.Add(&opcodes.Checkout{Branch: branch.name})
+	prog.Add(&opcodes.ResetCommitsInCurrentBranch{Parent: branch.parent})
+	prog.Add(&opcodes.CommitSquashedChanges{Message: branch.message})
+	if branch.hasTrackingBranch && online {
+		prog.Add(&opcodes.ForcePushCurrentBranch{})
+	}
+}
@@ -57,14 +57,18 @@ func printConfig(config *configdomain.FullConfig) {
 	print.Entry("parked branches", format.StringsSetting((config.ParkedBranches.Join(", "))))
 	print.Entry("contribution branches", format.StringsSetting((config.ContributionBranches.Join(", "))))
 	print.Entry("observed branches", format.StringsSetting((config.ObservedBranches.Join(", "))))
+	print.Entry("prototype branches", format.StringsSetting((config.PrototypeBranches.Join(", "))))
 	fmt.Println()
 	print.Header("Configuration")
 	print.Entry("offline", format.Bool(config.Offline.Bool()))
 	print.Entry("run pre-push hook", format.Bool(bool(config.PushHook)))
 	print.Entry("push new branches", format.Bool(config.ShouldPushNewBranches()))
+	print.Entry("proposals show the stack", format.Bool(config.ProposalsShowStack.Bool()))
 	print.Entry("ship deletes the tracking branch", format.Bool(config.ShipDeleteTrackingBranch.Bool()))
+	print.Entry("ship strategy", config.ShipStrategy.String())
 	print.Entry("sync-feature strategy", config.SyncFeatureStrategy.String())
 	print.Entry("sync-perennial strategy", config.SyncPerennialStrategy.String())
+	print.Entry("sync pushes branches", format.Bool(config.SyncPush.Bool()))
 	print.Entry("sync with upstream", format.Bool(config.SyncUpstream.Bool()))
 	print.Entry("sync before shipping", format.Bool(config.SyncBeforeShip.Bool()))
 	fmt.Println()
@@ -73,6 +77,11 @@ func printConfig(config *configdomain.FullConfig) {
 	print.Entry("GitHub token", format.StringSetting(string(config.GitHubToken)))
 	print.Entry("GitLab token", format.StringSetting(string(config.GitLabToken)))
 	print.Entry("Gitea token", format.StringSetting(string(config.GiteaToken)))
+	print.Entry("Forgejo token", format.StringSetting(string(config.ForgejoToken)))
+	print.Entry("Bitbucket username", format.StringSetting(string(config.BitbucketUsername)))
+	print.Entry("Bitbucket app password", format.StringSetting(string(config.BitbucketAppPassword)))
+	print.Entry("Bitbucket Data Center token", format.StringSetting(string(config.BitbucketDatacenterToken)))
+	print.Entry("Azure DevOps token", format.StringSetting(string(config.AzureDevOpsToken)))
 	fmt.Println()
 	if !config.MainBranch.IsEmpty() {
 		print.LabelAndValue("Branch Lineage", format.BranchLineage(config.Lineage))
@@ -127,8 +127,30 @@ func enterData(runner *git.ProdRunner, config *setupConfig) (aborted bool, err e
 		return aborted, err
 	}
 	switch determineHostingPlatform(runner, config.userInput.HostingPlatform) {
+	case configdomain.HostingPlatformAzureDevOps:
+		config.userInput.AzureDevOpsToken, aborted, err = dialog.AzureDevOpsToken(runner.Config.FullConfig.AzureDevOpsToken, config.dialogInputs.Next())
+		if err != nil || aborted {
+			return aborted, err
+		}
 	case configdomain.HostingPlatformBitbucket:
-		// BitBucket API isn't supported yet
+		config.userInput.BitbucketUsername, aborted, err = dialog.BitbucketUsername(runner.Config.FullConfig.BitbucketUsername, config.dialogInputs.Next())
+		if err != nil || aborted {
+			return aborted, err
+		}
+		config.userInput.BitbucketAppPassword, aborted, err = dialog.BitbucketAppPassword(runner.Config.FullConfig.BitbucketAppPassword, config.dialogInputs.Next())
+		if err != nil || aborted {
+			return aborted, err
+		}
+	case configdomain.HostingPlatformBitbucketDatacenter:
+		config.userInput.BitbucketDatacenterToken, aborted, err = dialog.BitbucketDatacenterToken(runner.Config.FullConfig.BitbucketDatacenterToken, config.dialogInputs.Next())
+		if err != nil || aborted {
+			return aborted, err
+		}
+	case configdomain.HostingPlatformForgejo:
+		config.userInput.ForgejoToken, aborted, err = dialog.ForgejoToken(runner.Config.FullConfig.ForgejoToken, config.dialogInputs.Next())
+		if err != nil || aborted {
+			return aborted, err
+		}
 	case configdomain.HostingPlatformGitea:
 		config.userInput.GiteaToken, aborted, err = dialog.GiteaToken(runner.Config.FullConfig.GiteaToken, config.dialogInputs.Next())
 		if err != nil || aborted {
@@ -210,6 +232,26 @@ func saveAll(runner *git.ProdRunner, userInput userInput) error {
 	if err != nil {
 		return err
 	}
+	err = saveAzureDevOpsToken(runner, userInput.AzureDevOpsToken)
+	if err != nil {
+		return err
+	}
+	err = saveBitbucketDatacenterToken(runner, userInput.BitbucketDatacenterToken)
+	if err != nil {
+		return err
+	}
+	err = saveBitbucketUsername(runner, userInput.BitbucketUsername)
+	if err != nil {
+		return err
+	}
+	err = saveBitbucketAppPassword(runner, userInput.BitbucketAppPassword)
+	if err != nil {
+		return err
+	}
+	err = saveForgejoToken(runner, userInput.ForgejoToken)
+	if err != nil {
+		return err
+	}
 	err = saveGiteaToken(runner, userInput.GiteaToken)
 	if err != nil {
 		return err
@@ -300,6 +342,41 @@ func saveAliases(runner *git.ProdRunner, newAliases configdomain.Aliases) (err e
 	return nil
 }
 
+func saveAzureDevOpsToken(runner *git.ProdRunner, newToken configdomain.AzureDevOpsToken) error {
+	if newToken == runner.Config.FullConfig.AzureDevOpsToken {
+		return nil
+	}
+	return runner.Frontend.SetAzureDevOpsToken(newToken)
+}
+
+func saveBitbucketAppPassword(runner *git.ProdRunner, newPassword configdomain.BitbucketAppPassword) error {
+	if newPassword == runner.Config.FullConfig.BitbucketAppPassword {
+		return nil
+	}
+	return runner.Frontend.SetBitbucketAppPassword(newPassword)
+}
+
+func saveBitbucketDatacenterToken(runner *git.ProdRunner, newToken configdomain.BitbucketDatacenterToken) error {
+	if newToken == runner.Config.FullConfig.BitbucketDatacenterToken {
+		return nil
+	}
+	return runner.Frontend.SetBitbucketDatacenterToken(newToken)
+}
+
+func saveBitbucketUsername(runner *git.ProdRunner, newUsername configdomain.BitbucketUsername) error {
+	if newUsername == runner.Config.FullConfig.BitbucketUsername {
+		return nil
+	}
+	return runner.Frontend.SetBitbucketUsername(newUsername)
+}
+
+func saveForgejoToken(runner *git.ProdRunner, newToken configdomain.ForgejoToken) error {
+	if newToken == runner.Config.FullConfig.ForgejoToken {
+		return nil
+	}
+	return runner.Frontend.SetForgejoToken(newToken)
+}
+
 func saveGiteaToken(runner *git.ProdRunner, newToken configdomain.GiteaToken) error {
 	if newToken == runner.Config.FullConfig.GiteaToken {
 		return nil
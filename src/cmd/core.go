@@ -10,28 +10,42 @@ import (
 func Execute() error {
 	rootCmd := rootCmd()
 	rootCmd.AddCommand(appendCmd())
+	rootCmd.AddCommand(bottomCmd())
+	rootCmd.AddCommand(branchCmd())
 	rootCmd.AddCommand(completionsCmd(&rootCmd))
+	rootCmd.AddCommand(compressCmd())
 	rootCmd.AddCommand(config.RootCmd())
 	rootCmd.AddCommand(continueCmd())
 	rootCmd.AddCommand(contributeCmd())
 	rootCmd.AddCommand(debug.RootCmd())
+	rootCmd.AddCommand(detachCommand())
 	rootCmd.AddCommand(diffParentCommand())
+	rootCmd.AddCommand(downCmd())
 	rootCmd.AddCommand(hackCmd())
+	rootCmd.AddCommand(historyCmd())
 	rootCmd.AddCommand(killCommand())
+	rootCmd.AddCommand(mergeCommand())
 	rootCmd.AddCommand(newPullRequestCommand())
 	rootCmd.AddCommand(observeCmd())
 	rootCmd.AddCommand(offlineCmd())
 	rootCmd.AddCommand(parkCmd())
 	rootCmd.AddCommand(proposeCommand())
 	rootCmd.AddCommand(prependCommand())
+	rootCmd.AddCommand(prototypeCmd())
+	rootCmd.AddCommand(pruneCommand())
+	rootCmd.AddCommand(redoCmd())
 	rootCmd.AddCommand(renameBranchCommand())
 	rootCmd.AddCommand(repoCommand())
 	rootCmd.AddCommand(statusCommand())
 	rootCmd.AddCommand(setParentCommand())
 	rootCmd.AddCommand(shipCmd())
 	rootCmd.AddCommand(skipCmd())
+	rootCmd.AddCommand(swapCommand())
 	rootCmd.AddCommand(switchCmd())
 	rootCmd.AddCommand(syncCmd())
+	rootCmd.AddCommand(topCmd())
 	rootCmd.AddCommand(undoCmd())
+	rootCmd.AddCommand(upCmd())
+	rootCmd.AddCommand(walkCommand())
 	return rootCmd.Execute()
 }
@@ -0,0 +1,21 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+func enterBitbucketDatacenterToken() *cobra.Command {
+	return &cobra.Command{
+		Use: "bitbucket-datacenter-token",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			dialogInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.BitbucketDatacenterToken(configdomain.BitbucketDatacenterToken(""), dialogInputs.Next())
+			return err
+		},
+	}
+}
@@ -11,6 +11,11 @@ func RootCmd() *cobra.Command {
 		Hidden: true,
 	}
 	debugCommand.AddCommand(enterAliases())
+	debugCommand.AddCommand(enterAzureDevOpsToken())
+	debugCommand.AddCommand(enterBitbucketAppPassword())
+	debugCommand.AddCommand(enterBitbucketDatacenterToken())
+	debugCommand.AddCommand(enterBitbucketUsername())
+	debugCommand.AddCommand(enterForgejoToken())
 	debugCommand.AddCommand(enterHostingPlatform())
 	debugCommand.AddCommand(enterGiteaToken())
 	debugCommand.AddCommand(enterGitHubToken())
@@ -27,7 +32,9 @@ func RootCmd() *cobra.Command {
 	debugCommand.AddCommand(enterPushNewBranches())
 	debugCommand.AddCommand(enterShipDeleteTrackingBranch())
 	debugCommand.AddCommand(enterSyncBeforeShip())
+	debugCommand.AddCommand(selectChildBranchCmd())
 	debugCommand.AddCommand(selectCommitAuthorCmd())
+	debugCommand.AddCommand(selectPruneBranchesCmd())
 	debugCommand.AddCommand(switchBranch())
 	debugCommand.AddCommand(unfinishedStateCommitAuthorCmd())
 	debugCommand.AddCommand(welcome())
@@ -0,0 +1,23 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/spf13/cobra"
+)
+
+func selectChildBranchCmd() *cobra.Command {
+	return &cobra.Command{
+		Use: "select-child-branch",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			branch := gitdomain.NewLocalBranchName("parent")
+			children := gitdomain.NewLocalBranchNames("child-1", "child-2", "child-3")
+			dialogTestInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.ChildBranch(branch, children, dialogTestInputs.Next())
+			return err
+		},
+	}
+}
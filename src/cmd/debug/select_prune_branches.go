@@ -0,0 +1,22 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/spf13/cobra"
+)
+
+func selectPruneBranchesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use: "select-prune-branches",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			candidates := gitdomain.NewLocalBranchNames("branch-1", "branch-2", "branch-3")
+			dialogTestInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.PruneBranches(candidates, dialogTestInputs.Next())
+			return err
+		},
+	}
+}
@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const detachDesc = "Moves the current branch out of its stack and onto the main branch"
+
+const detachHelp = `
+Rebases the commits of the current branch onto the main branch and makes it a child of the main branch.
+The children of the current branch become children of its former parent branch.
+Force-pushes all changed branches and updates the target branches of their proposals.
+
+The current branch must be a feature branch that is in sync with its parent and tracking branch.`
+
+func detachCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	cmd := cobra.Command{
+		Use:     "detach",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   detachDesc,
+		Long:    cmdhelpers.Long(detachDesc, detachHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeDetach(readDryRunFlag(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeDetach(dryRun, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineDetachConfig(repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "detach",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            detachProgram(config),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               config.connector,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          false,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type detachConfig struct {
+	*configdomain.FullConfig
+	childProposals   []hostingdomain.Proposal
+	children         gitdomain.BranchInfos // the children of the branch to detach
+	connector        hostingdomain.Connector
+	current          gitdomain.BranchInfo
+	currentProposal  *hostingdomain.Proposal
+	dialogTestInputs components.TestInputs
+	dryRun           bool
+	parent           gitdomain.BranchInfo
+	previousBranch   gitdomain.LocalBranchName
+}
+
+func determineDetachConfig(repo *execute.OpenRepoResult, dryRun, verbose bool) (*detachConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	fc := execute.FailureCollector{}
+	branchesSnapshot, stashSize, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: true,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	err = execute.EnsureKnownBranchAncestry(branchesSnapshot.Active, execute.EnsureKnownBranchAncestryArgs{
+		Config:           &repo.Runner.Config.FullConfig,
+		AllBranches:      branchesSnapshot.Branches,
+		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+		DialogTestInputs: &dialogTestInputs,
+		Runner:           repo.Runner,
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	current := branchesSnapshot.Branches.FindByLocalName(branchesSnapshot.Active)
+	if current == nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchesSnapshot.Active)
+	}
+	branchType := repo.Runner.Config.FullConfig.BranchType(current.LocalName)
+	if !branchType.IsRewritable() {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.DetachBranchType, branchType, current.LocalName)
+	}
+	parentName := lineage.Parent(current.LocalName)
+	if parentName == repo.Runner.Config.FullConfig.MainBranch {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.DetachParentIsMain, current.LocalName)
+	}
+	parent := branchesSnapshot.Branches.FindByLocalName(parentName)
+	if parent == nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, parentName)
+	}
+	switch current.SyncStatus {
+	case gitdomain.SyncStatusDeletedAtRemote, gitdomain.SyncStatusNotInSync, gitdomain.SyncStatusOtherWorktree, gitdomain.SyncStatusRemoteOnly:
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchNotInSync, current.LocalName)
+	case gitdomain.SyncStatusLocalOnly, gitdomain.SyncStatusUpToDate:
+	}
+	if !repo.Runner.Backend.BranchInSyncWithParent(current.LocalName, parentName) {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchNotInSync, current.LocalName)
+	}
+	children := fc.BranchInfos(branchesSnapshot.Branches.Select(lineage.Children(current.LocalName)))
+	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		FullConfig:      &repo.Runner.Config.FullConfig,
+		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
+		Log:             print.Logger{},
+		OriginURL:       repo.Runner.Config.OriginURL(),
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	var currentProposal *hostingdomain.Proposal
+	childProposals := []hostingdomain.Proposal{}
+	if !repo.IsOffline && connector != nil {
+		if current.HasTrackingBranch() {
+			currentProposal, err = connector.FindProposal(current.LocalName, parentName)
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ProposalNotFoundForBranch, current.LocalName, err)
+			}
+		}
+		for _, child := range children {
+			childProposal, err := connector.FindProposal(child.LocalName, current.LocalName)
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ProposalNotFoundForBranch, child.LocalName, err)
+			}
+			if childProposal != nil {
+				childProposals = append(childProposals, *childProposal)
+			}
+		}
+	}
+	return &detachConfig{
+		FullConfig:       &repo.Runner.Config.FullConfig,
+		childProposals:   childProposals,
+		children:         children,
+		connector:        connector,
+		current:          *current,
+		currentProposal:  currentProposal,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		parent:           *parent,
+		previousBranch:   repo.Runner.Backend.PreviouslyCheckedOutBranch(),
+	}, branchesSnapshot, stashSize, false, fc.Err
+}
+
+func detachProgram(config *detachConfig) program.Program {
+	prog := program.Program{}
+	// move the commits of the current branch onto the main branch
+	rebaseBranchOnto(&prog, config.current, config.MainBranch.BranchName(), config.parent.LocalName.Location(), config.IsOnline())
+	// remove the commits of the current branch from its children
+	for _, child := range config.children {
+		rebaseBranchOnto(&prog, child, config.parent.LocalName.BranchName(), config.current.LocalSHA.Location(), config.IsOnline())
+	}
+	prog.Add(&opcodes.ChangeParent{Branch: config.current.LocalName, Parent: config.MainBranch})
+	for _, child := range config.children {
+		prog.Add(&opcodes.ChangeParent{Branch: child.LocalName, Parent: config.parent.LocalName})
+	}
+	if config.currentProposal != nil {
+		prog.Add(&opcodes.UpdateProposalTarget{
+			NewTarget:      config.MainBranch,
+			ProposalNumber: config.currentProposal.Number,
+		})
+	}
+	for _, childProposal := range config.childProposals {
+		prog.Add(&opcodes.UpdateProposalTarget{
+			NewTarget:      config.parent.LocalName,
+			ProposalNumber: childProposal.Number,
+		})
+	}
+	prog.Add(&opcodes.Checkout{Branch: config.current.LocalName})
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   config.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         false,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
+	})
+	return prog
+}
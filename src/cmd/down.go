@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const downDesc = "Switches to the parent branch of the current branch"
+
+func downCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "down",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   downDesc,
+		Long:    cmdhelpers.Long(downDesc),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeDown(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeDown(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineNavigateConfig(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	parent := repo.Runner.Config.FullConfig.Lineage.Parent(config.initialBranch)
+	if parent.IsEmpty() {
+		return fmt.Errorf(messages.NavigateNoParent, config.initialBranch)
+	}
+	return navigateTo(parent, repo, config)
+}
@@ -27,11 +27,14 @@ const hackDesc = "Creates a new feature branch off the main development branch"
 const hackHelp = `
 Syncs the main branch, forks a new feature branch with the given name off the main branch, pushes the new feature branch to origin (if and only if "push-new-branches" is true), and brings over all uncommitted changes to the new feature branch.
 
+With the --prototype flag, creates the new branch as a prototype branch that Git Town never pushes to origin.
+
 See "sync" for information regarding upstream remotes.`
 
 func hackCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPrototypeFlag, readPrototypeFlag := flags.Bool("prototype", "p", "Create a prototype branch that never gets pushed", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:     "hack <branch>",
 		GroupID: "basic",
@@ -39,15 +42,16 @@ func hackCmd() *cobra.Command {
 		Short:   hackDesc,
 		Long:    cmdhelpers.Long(hackDesc, hackHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeHack(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeHack(args, readPrototypeFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addPrototypeFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeHack(args []string, dryRun, verbose bool) error {
+func executeHack(args []string, prototype, dryRun, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -59,7 +63,7 @@ func executeHack(args []string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineHackConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineHackConfig(args, repo, prototype, dryRun, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -76,6 +80,9 @@ func executeHack(args []string, dryRun, verbose bool) error {
 		})
 	}
 	if config.makeFeatureConfig != nil {
+		if prototype {
+			return errors.New(messages.HackPrototypeExistingBranch)
+		}
 		return makeFeatureBranch(makeFeatureBranchArgs{
 			beginConfigSnapshot: repo.ConfigSnapshot,
 			config:              repo.Runner.Config,
@@ -93,7 +100,7 @@ type hackConfig struct {
 	makeFeatureConfig *makeFeatureConfig
 }
 
-// this configuration is for when "git hack" is used to make contribution, observed, or parked branches feature branches
+// this configuration is for when "git hack" is used to make contribution, observed, parked, or prototype branches feature branches
 type makeFeatureConfig struct {
 	targetBranches commandconfig.BranchesAndTypes
 }
@@ -136,7 +143,7 @@ type createBranchArgs struct {
 	verbose               bool
 }
 
-func determineHackConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*hackConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineHackConfig(args []string, repo *execute.OpenRepoResult, prototype, dryRun, verbose bool) (*hackConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	fc := execute.FailureCollector{}
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
@@ -195,6 +202,7 @@ func determineHackConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 			newBranchParentCandidates: gitdomain.LocalBranchNames{repo.Runner.Config.FullConfig.MainBranch},
 			parentBranch:              repo.Runner.Config.FullConfig.MainBranch,
 			previousBranch:            previousBranch,
+			prototype:                 prototype,
 			remotes:                   remotes,
 			targetBranch:              targetBranch,
 		},
@@ -215,6 +223,8 @@ func makeFeatureBranch(args makeFeatureBranchArgs) error {
 			err = args.config.RemoveFromObservedBranches(branchName)
 		case configdomain.BranchTypeParkedBranch:
 			err = args.config.RemoveFromParkedBranches(branchName)
+		case configdomain.BranchTypePrototypeBranch:
+			err = args.config.RemoveFromPrototypeBranches(branchName)
 		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 			panic(fmt.Sprintf("unchecked branch type: %s", branchType))
 		}
@@ -245,7 +255,7 @@ type makeFeatureBranchArgs struct {
 func validateMakeFeatureConfig(config *makeFeatureConfig) error {
 	for branchName, branchType := range config.targetBranches {
 		switch branchType {
-		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 			return nil
 		case configdomain.BranchTypeFeatureBranch:
 			return fmt.Errorf(messages.HackBranchIsAlreadyFeature, branchName)
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undobranches"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/git-town/git-town/v12/src/vm/statefile"
+	"github.com/spf13/cobra"
+)
+
+const historyDesc = "Displays the most recent Git Town commands"
+
+const historyHelp = `
+Lists the most recent Git Town commands that ran in this repository,
+most recent first, together with the time they finished and the branches they changed.
+The number in front of each command is the number of steps
+to provide to "git town undo --steps" to undo all commands up to and including it.`
+
+func historyCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "history",
+		GroupID: "errors",
+		Args:    cobra.NoArgs,
+		Short:   historyDesc,
+		Long:    cmdhelpers.Long(historyDesc, historyHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeHistory(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeHistory(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	history, err := statefile.LoadHistory(repo.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateLoadProblem, err)
+	}
+	if len(history) == 0 {
+		fmt.Println(messages.HistoryEmpty)
+	} else {
+		fmt.Print(formatHistory(history))
+	}
+	print.Footer(verbose, repo.Runner.CommandsCounter.Count(), print.NoFinalMessages)
+	return nil
+}
+
+// formatHistory provides the printable version of the given history, most recent command first.
+func formatHistory(history runstate.History) string {
+	entries := history.Last(len(history))
+	numberWidth := len(strconv.Itoa(len(entries)))
+	commandWidth := 0
+	for _, entry := range entries {
+		commandWidth = max(commandWidth, len(entry.RunState.Command))
+	}
+	result := strings.Builder{}
+	for e, entry := range entries {
+		branches := messages.HistoryNoBranchesChanged
+		changedBranches := undobranches.NewBranchSpans(entry.RunState.BeginBranchesSnapshot, entry.RunState.EndBranchesSnapshot).ChangedBranches()
+		if len(changedBranches) > 0 {
+			branches = changedBranches.Join(", ")
+		}
+		result.WriteString(fmt.Sprintf("%*d  %s  %-*s  %s\n", numberWidth, e+1, entry.EndTime.Format("2006-01-02 15:04:05"), commandWidth, entry.RunState.Command, branches))
+	}
+	return result.String()
+}
@@ -3,7 +3,9 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 
 	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/cli/flags"
@@ -11,7 +13,6 @@ import (
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/execute"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
-	"github.com/git-town/git-town/v12/src/gohacks/slice"
 	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/git-town/git-town/v12/src/sync"
 	"github.com/git-town/git-town/v12/src/undo/undoconfig"
@@ -22,29 +23,33 @@ import (
 	"github.com/spf13/cobra"
 )
 
-const killDesc = "Removes an obsolete feature branch"
+const killDesc = "Removes obsolete feature branches"
 
 const killHelp = `
-Deletes the current or provided branch from the local and origin repositories. Does not delete perennial branches nor the main branch.`
+Deletes the current or provided branches from the local and origin repositories. Does not delete perennial branches nor the main branch.
+
+If you provide the --stack switch, also deletes all descendants of these branches.`
 
 func killCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Also kill all descendants of the given branches", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
-		Use:   "kill [<branch>]",
-		Args:  cobra.MaximumNArgs(1),
+		Use:   "kill [<branch>...]",
+		Args:  cobra.ArbitraryArgs,
 		Short: killDesc,
 		Long:  cmdhelpers.Long(killDesc, killHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeKill(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeKill(args, readStackFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addStackFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeKill(args []string, dryRun, verbose bool) error {
+func executeKill(args []string, stack, dryRun, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -56,7 +61,7 @@ func executeKill(args []string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineKillConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineKillConfig(args, repo, stack, dryRun, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -94,9 +99,8 @@ func executeKill(args []string, dryRun, verbose bool) error {
 
 type killConfig struct {
 	*configdomain.FullConfig
-	branchNameToKill gitdomain.BranchInfo
-	branchTypeToKill configdomain.BranchType
 	branchWhenDone   gitdomain.LocalBranchName
+	branchesToKill   gitdomain.BranchInfos // ordered so that descendants come before their ancestors
 	dialogTestInputs components.TestInputs
 	dryRun           bool
 	hasOpenChanges   bool
@@ -104,7 +108,7 @@ type killConfig struct {
 	previousBranch   gitdomain.LocalBranchName
 }
 
-func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*killConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineKillConfig(args []string, repo *execute.OpenRepoResult, stack, dryRun, verbose bool) (*killConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -119,39 +123,56 @@ func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	if err != nil || exit {
 		return nil, branchesSnapshot, stashSize, exit, err
 	}
-	branchNameToKill := gitdomain.NewLocalBranchName(slice.FirstElementOr(args, branchesSnapshot.Active.String()))
-	branchToKill := branchesSnapshot.Branches.FindByLocalName(branchNameToKill)
-	if branchToKill == nil {
-		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToKill)
-	}
-	if branchToKill.SyncStatus == gitdomain.SyncStatusOtherWorktree {
-		return nil, branchesSnapshot, stashSize, exit, fmt.Errorf(messages.KillBranchOtherWorktree, branchNameToKill)
-	}
-	if branchToKill.IsLocal() {
-		err = execute.EnsureKnownBranchAncestry(branchToKill.LocalName, execute.EnsureKnownBranchAncestryArgs{
-			Config:           &repo.Runner.Config.FullConfig,
-			AllBranches:      branchesSnapshot.Branches,
-			DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
-			DialogTestInputs: &dialogTestInputs,
-			Runner:           repo.Runner,
-		})
-		if err != nil {
-			return nil, branchesSnapshot, stashSize, false, err
+	branchNamesToKill := gitdomain.NewLocalBranchNames(args...)
+	if len(branchNamesToKill) == 0 {
+		branchNamesToKill = gitdomain.LocalBranchNames{branchesSnapshot.Active}
+	}
+	branchNamesToKill = gitdomain.LocalBranchNames{}.AppendAllMissing(branchNamesToKill...)
+	for _, branchNameToKill := range branchNamesToKill {
+		branchToKill := branchesSnapshot.Branches.FindByLocalName(branchNameToKill)
+		if branchToKill == nil {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToKill)
+		}
+		if branchToKill.IsLocal() {
+			err = execute.EnsureKnownBranchAncestry(branchToKill.LocalName, execute.EnsureKnownBranchAncestryArgs{
+				Config:           &repo.Runner.Config.FullConfig,
+				AllBranches:      branchesSnapshot.Branches,
+				DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+				DialogTestInputs: &dialogTestInputs,
+				Runner:           repo.Runner,
+			})
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, err
+			}
+		}
+	}
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	if stack {
+		for _, branchNameToKill := range branchNamesToKill {
+			branchNamesToKill = branchNamesToKill.AppendAllMissing(lineage.Descendants(branchNameToKill)...)
 		}
 	}
-	branchTypeToKill := repo.Runner.Config.FullConfig.BranchType(branchNameToKill)
+	sortDescendantsFirst(branchNamesToKill, lineage)
+	branchesToKill := make(gitdomain.BranchInfos, 0, len(branchNamesToKill))
+	for _, branchNameToKill := range branchNamesToKill {
+		branchToKill := branchesSnapshot.Branches.FindByLocalName(branchNameToKill)
+		if branchToKill == nil {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToKill)
+		}
+		if branchToKill.SyncStatus == gitdomain.SyncStatusOtherWorktree {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.KillBranchOtherWorktree, branchNameToKill)
+		}
+		branchesToKill = append(branchesToKill, *branchToKill)
+	}
 	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
-	var branchWhenDone gitdomain.LocalBranchName
-	if branchNameToKill == branchesSnapshot.Active {
-		branchWhenDone = previousBranch
-	} else {
-		branchWhenDone = branchesSnapshot.Active
+	branchWhenDone := branchesSnapshot.Active
+	if branchNamesToKill.Contains(branchesSnapshot.Active) {
+		branchWhenDone = killBranchWhenDone(branchesSnapshot.Active, previousBranch, branchNamesToKill, repo.Runner.Config.FullConfig)
 	}
 	return &killConfig{
 		FullConfig:       &repo.Runner.Config.FullConfig,
-		branchNameToKill: *branchToKill,
-		branchTypeToKill: branchTypeToKill,
 		branchWhenDone:   branchWhenDone,
+		branchesToKill:   branchesToKill,
 		dialogTestInputs: dialogTestInputs,
 		dryRun:           dryRun,
 		hasOpenChanges:   repoStatus.OpenChanges,
@@ -160,69 +181,109 @@ func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	}, branchesSnapshot, stashSize, false, nil
 }
 
-func (self killConfig) branchToKillParent() gitdomain.LocalBranchName {
-	return self.Lineage.Parent(self.branchNameToKill.LocalName)
+// sortDescendantsFirst sorts the given branches in place so that descendants come before their ancestors.
+// Killing branches in this order keeps the lineage consistent.
+func sortDescendantsFirst(branches gitdomain.LocalBranchNames, lineage configdomain.Lineage) {
+	branches.Sort()
+	slices.SortStableFunc(branches, func(a, b gitdomain.LocalBranchName) int {
+		return len(lineage.Ancestors(b)) - len(lineage.Ancestors(a))
+	})
+}
+
+// killBranchWhenDone provides the branch to check out after killing the current branch.
+// This is the previously checked out branch if it survives, otherwise the closest surviving ancestor of the current branch.
+func killBranchWhenDone(initialBranch, previousBranch gitdomain.LocalBranchName, branchesToKill gitdomain.LocalBranchNames, config configdomain.FullConfig) gitdomain.LocalBranchName {
+	if !previousBranch.IsEmpty() && !branchesToKill.Contains(previousBranch) {
+		return previousBranch
+	}
+	ancestors := config.Lineage.Ancestors(initialBranch)
+	slices.Reverse(ancestors)
+	for _, ancestor := range ancestors {
+		if !branchesToKill.Contains(ancestor) {
+			return ancestor
+		}
+	}
+	return config.MainBranch
 }
 
 func killProgram(config *killConfig) (runProgram, finalUndoProgram program.Program) {
 	prog := program.Program{}
-	switch config.branchTypeToKill {
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
-		killFeatureBranch(&prog, &finalUndoProgram, config)
-	case configdomain.BranchTypeObservedBranch, configdomain.BranchTypeContributionBranch:
-		killLocalBranch(&prog, &finalUndoProgram, config)
-	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
-		panic(fmt.Sprintf("this branch type should have been filtered in validation: %s", config.branchTypeToKill))
+	// the lineage as it will be after killing the branches processed so far
+	lineage := maps.Clone(config.Lineage)
+	for _, branchToKill := range config.branchesToKill {
+		switch branchType := config.BranchType(branchToKill.LocalName); branchType {
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+			killFeatureBranch(&prog, &finalUndoProgram, branchToKill, lineage, config)
+		case configdomain.BranchTypeObservedBranch, configdomain.BranchTypeContributionBranch:
+			killLocalBranch(&prog, &finalUndoProgram, branchToKill, lineage, config)
+		case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+			panic(fmt.Sprintf("this branch type should have been filtered in validation: %s", branchType))
+		}
+		lineage.RemoveBranch(branchToKill.LocalName)
 	}
 	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
 		DryRun:                   config.dryRun,
 		RunInGitRoot:             true,
-		StashOpenChanges:         config.initialBranch != config.branchNameToKill.LocalName && config.hasOpenChanges,
+		StashOpenChanges:         !config.killsInitialBranch() && config.hasOpenChanges,
 		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch, config.initialBranch},
 	})
 	return prog, finalUndoProgram
 }
 
 // killFeatureBranch kills the given feature branch everywhere it exists (locally and remotely).
-func killFeatureBranch(prog *program.Program, finalUndoProgram *program.Program, config *killConfig) {
-	if config.branchNameToKill.HasTrackingBranch() && config.IsOnline() {
-		prog.Add(&opcodes.DeleteTrackingBranch{Branch: config.branchNameToKill.RemoteName})
+func killFeatureBranch(prog *program.Program, finalUndoProgram *program.Program, branchToKill gitdomain.BranchInfo, lineage configdomain.Lineage, config *killConfig) {
+	if branchToKill.HasTrackingBranch() && config.IsOnline() {
+		prog.Add(&opcodes.DeleteTrackingBranch{Branch: branchToKill.RemoteName})
 	}
-	killLocalBranch(prog, finalUndoProgram, config)
+	killLocalBranch(prog, finalUndoProgram, branchToKill, lineage, config)
 }
 
-// killFeatureBranch kills the given feature branch everywhere it exists (locally and remotely).
-func killLocalBranch(prog *program.Program, finalUndoProgram *program.Program, config *killConfig) {
-	if config.initialBranch == config.branchNameToKill.LocalName {
+// killLocalBranch kills the given branch in the local repository.
+func killLocalBranch(prog *program.Program, finalUndoProgram *program.Program, branchToKill gitdomain.BranchInfo, lineage configdomain.Lineage, config *killConfig) {
+	if config.initialBranch == branchToKill.LocalName {
 		if config.hasOpenChanges {
 			prog.Add(&opcodes.CommitOpenChanges{})
 			// update the registered initial SHA for this branch so that undo restores the just committed changes
 			prog.Add(&opcodes.UpdateInitialBranchLocalSHA{Branch: config.initialBranch})
 			// when undoing, manually undo the just committed changes so that they are uncommitted again
-			finalUndoProgram.Add(&opcodes.Checkout{Branch: config.branchNameToKill.LocalName})
+			finalUndoProgram.Add(&opcodes.Checkout{Branch: branchToKill.LocalName})
 			finalUndoProgram.Add(&opcodes.UndoLastCommit{})
 		}
 		prog.Add(&opcodes.Checkout{Branch: config.branchWhenDone})
 	}
-	prog.Add(&opcodes.DeleteLocalBranch{Branch: config.branchNameToKill.LocalName})
+	prog.Add(&opcodes.DeleteLocalBranch{Branch: branchToKill.LocalName})
 	if !config.dryRun {
 		sync.RemoveBranchFromLineage(sync.RemoveBranchFromLineageArgs{
-			Branch:  config.branchNameToKill.LocalName,
-			Lineage: config.Lineage,
-			Parent:  config.branchToKillParent(),
+			Branch:  branchToKill.LocalName,
+			Lineage: lineage,
+			Parent:  lineage.Parent(branchToKill.LocalName),
 			Program: prog,
 		})
 	}
 }
 
+func (self killConfig) killsInitialBranch() bool {
+	return self.branchesToKill.FindByLocalName(self.initialBranch) != nil
+}
+
 func validateKillConfig(killConfig *killConfig) error {
-	switch killConfig.branchTypeToKill {
-	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+	for _, branchToKill := range killConfig.branchesToKill {
+		err := validateKillBranchType(killConfig.BranchType(branchToKill.LocalName))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateKillBranchType(branchType configdomain.BranchType) error {
+	switch branchType {
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch:
 		return errors.New(messages.KillCannotKillMainBranch)
 	case configdomain.BranchTypePerennialBranch:
 		return errors.New(messages.KillCannotKillPerennialBranches)
 	}
-	panic(fmt.Sprintf("unhandled branch type: %s", killConfig.branchTypeToKill))
+	panic(fmt.Sprintf("unhandled branch type: %s", branchType))
 }
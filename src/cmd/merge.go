@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/sync"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const mergeDesc = "Merges the current branch into its parent branch"
+
+const mergeHelp = `
+Merges the commits of the current branch into its parent branch
+and deletes the current branch from the local and origin repositories.
+The children of the current branch become children of its parent branch.
+Proposals of these children now target the parent branch.
+The proposal of the current branch gets closed.
+
+Both branches must be feature branches that are in sync with their parent and tracking branches.`
+
+func mergeCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	cmd := cobra.Command{
+		Use:     "merge",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   mergeDesc,
+		Long:    cmdhelpers.Long(mergeDesc, mergeHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeMerge(readDryRunFlag(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeMerge(dryRun, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineMergeConfig(repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "merge",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            mergeProgram(config),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               config.connector,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          false,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type mergeConfig struct {
+	*configdomain.FullConfig
+	childProposals   []hostingdomain.Proposal // the proposals of the children of the current branch
+	connector        hostingdomain.Connector
+	current          gitdomain.BranchInfo
+	dialogTestInputs components.TestInputs
+	dryRun           bool
+	parent           gitdomain.BranchInfo
+	previousBranch   gitdomain.LocalBranchName
+	proposal         *hostingdomain.Proposal // the proposal of the current branch
+}
+
+func determineMergeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool) (*mergeConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	branchesSnapshot, stashSize, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: true,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	err = execute.EnsureKnownBranchAncestry(branchesSnapshot.Active, execute.EnsureKnownBranchAncestryArgs{
+		Config:           &repo.Runner.Config.FullConfig,
+		AllBranches:      branchesSnapshot.Branches,
+		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+		DialogTestInputs: &dialogTestInputs,
+		Runner:           repo.Runner,
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	current := branchesSnapshot.Branches.FindByLocalName(branchesSnapshot.Active)
+	if current == nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchesSnapshot.Active)
+	}
+	currentType := repo.Runner.Config.FullConfig.BranchType(current.LocalName)
+	if !currentType.IsRewritable() {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.MergeBranchType, currentType, current.LocalName)
+	}
+	parentName := lineage.Parent(current.LocalName)
+	parentType := repo.Runner.Config.FullConfig.BranchType(parentName)
+	if !parentType.IsRewritable() {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.MergeIntoBranchType, parentType, parentName)
+	}
+	parent := branchesSnapshot.Branches.FindByLocalName(parentName)
+	if parent == nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, parentName)
+	}
+	for _, branch := range []gitdomain.BranchInfo{*current, *parent} {
+		switch branch.SyncStatus {
+		case gitdomain.SyncStatusDeletedAtRemote, gitdomain.SyncStatusNotInSync, gitdomain.SyncStatusOtherWorktree, gitdomain.SyncStatusRemoteOnly:
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchNotInSync, branch.LocalName)
+		case gitdomain.SyncStatusLocalOnly, gitdomain.SyncStatusUpToDate:
+		}
+	}
+	if !repo.Runner.Backend.BranchInSyncWithParent(current.LocalName, parentName) {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchNotInSync, current.LocalName)
+	}
+	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		FullConfig:      &repo.Runner.Config.FullConfig,
+		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
+		Log:             print.Logger{},
+		OriginURL:       repo.Runner.Config.OriginURL(),
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	childProposals := []hostingdomain.Proposal{}
+	var proposal *hostingdomain.Proposal
+	if !repo.IsOffline && connector != nil {
+		proposal, err = connector.FindProposal(current.LocalName, parentName)
+		if err != nil {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ProposalNotFoundForBranch, current.LocalName, err)
+		}
+		for _, child := range lineage.Children(current.LocalName) {
+			childProposal, err := connector.FindProposal(child, current.LocalName)
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ProposalNotFoundForBranch, child, err)
+			}
+			if childProposal != nil {
+				childProposals = append(childProposals, *childProposal)
+			}
+		}
+	}
+	return &mergeConfig{
+		FullConfig:       &repo.Runner.Config.FullConfig,
+		childProposals:   childProposals,
+		connector:        connector,
+		current:          *current,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		parent:           *parent,
+		previousBranch:   repo.Runner.Backend.PreviouslyCheckedOutBranch(),
+		proposal:         proposal,
+	}, branchesSnapshot, stashSize, false, nil
+}
+
+func mergeProgram(config *mergeConfig) program.Program {
+	prog := program.Program{}
+	prog.Add(&opcodes.Checkout{Branch: config.parent.LocalName})
+	prog.Add(&opcodes.Merge{Branch: config.current.LocalName.BranchName()})
+	if config.parent.HasTrackingBranch() && config.IsOnline() {
+		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: config.parent.LocalName})
+	}
+	// point the proposals of the child branches to the parent branch before deleting the current branch,
+	// the code hosting platform would close them otherwise
+	for _, childProposal := range config.childProposals {
+		prog.Add(&opcodes.UpdateProposalTarget{
+			NewTarget:      config.parent.LocalName,
+			ProposalNumber: childProposal.Number,
+		})
+	}
+	// the changes of the current branch are now part of the parent branch and get reviewed there
+	if config.proposal != nil {
+		prog.Add(&opcodes.ConnectorCloseProposal{ProposalNumber: config.proposal.Number})
+	}
+	if config.current.HasTrackingBranch() && config.IsOnline() {
+		prog.Add(&opcodes.DeleteTrackingBranch{Branch: config.current.RemoteName})
+	}
+	prog.Add(&opcodes.DeleteLocalBranch{Branch: config.current.LocalName})
+	if !config.dryRun {
+		sync.RemoveBranchFromLineage(sync.RemoveBranchFromLineageArgs{
+			Branch:  config.current.LocalName,
+			Lineage: config.Lineage,
+			Parent:  config.parent.LocalName,
+			Program: &prog,
+		})
+	}
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   config.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         false,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
+	})
+	return prog
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// navigateConfig contains the information needed by the stack navigation commands "up", "down", "top", and "bottom".
+type navigateConfig struct {
+	dialogTestInputs components.TestInputs
+	initialBranch    gitdomain.LocalBranchName
+}
+
+func determineNavigateConfig(repo *execute.OpenRepoResult, verbose bool) (*navigateConfig, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	branchesSnapshot, _, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 false,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: true,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	err = execute.EnsureKnownBranchAncestry(branchesSnapshot.Active, execute.EnsureKnownBranchAncestryArgs{
+		Config:           &repo.Runner.Config.FullConfig,
+		AllBranches:      branchesSnapshot.Branches,
+		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+		DialogTestInputs: &dialogTestInputs,
+		Runner:           repo.Runner,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &navigateConfig{
+		dialogTestInputs: dialogTestInputs,
+		initialBranch:    branchesSnapshot.Active,
+	}, false, nil
+}
+
+// navigateToChild provides the child branch of the given branch to navigate to.
+// If the given branch has multiple children, it asks the user which one to use.
+func navigateToChild(branch gitdomain.LocalBranchName, repo *execute.OpenRepoResult, config *navigateConfig) (gitdomain.LocalBranchName, bool, error) {
+	children := repo.Runner.Config.FullConfig.Lineage.Children(branch)
+	switch len(children) {
+	case 0:
+		return gitdomain.EmptyLocalBranchName(), false, fmt.Errorf(messages.NavigateNoChildren, branch)
+	case 1:
+		return children[0], false, nil
+	}
+	return dialog.ChildBranch(branch, children, config.dialogTestInputs.Next())
+}
+
+// navigateTo checks out the given branch.
+func navigateTo(branch gitdomain.LocalBranchName, repo *execute.OpenRepoResult, config *navigateConfig) error {
+	if branch == config.initialBranch {
+		return nil
+	}
+	return repo.Runner.Frontend.CheckoutBranch(branch)
+}
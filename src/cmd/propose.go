@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/git-town/git-town/v12/src/cli/dialog/components"
@@ -12,7 +13,9 @@ import (
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/config/gitconfig"
 	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/commitmessage"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
 	"github.com/git-town/git-town/v12/src/hosting"
 	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v12/src/messages"
@@ -28,15 +31,24 @@ import (
 const proposeDesc = "Creates a proposal to merge a feature branch"
 
 const proposeHelp = `
-Syncs the current branch and opens a browser window to the new proposal page of your repository.
+Syncs the current branch and creates a proposal for it.
 
-The form is pre-populated for the current branch so that the proposal only shows the changes made against the immediate parent branch.
+If an API token for your code hosting platform is configured, creates the proposal via the API of the code hosting platform and prints its URL. The --title, --body, --body-file, and --draft flags customize the created proposal. Without a title, the proposal uses the message of the first commit on the current branch. If the current branch already has a proposal, prints the URL of that proposal instead of creating a new one.
+
+Otherwise opens a browser window to the new proposal page of your repository. The form is pre-populated for the current branch so that the proposal only shows the changes made against the immediate parent branch.
+
+With the --stack switch, proposes the current branch and all its ancestor branches. Pushes branches that don't have a tracking branch yet, creates proposals against the parent branch for branches that don't have one, and updates the target branch of existing proposals that don't target the parent branch. This requires an API token.
 
 Supported only for repositories hosted on GitHub, GitLab, Gitea and Bitbucket. When using self-hosted versions this command needs to be configured with "git config %s <driver>" where driver is "github", "gitlab", "gitea", or "bitbucket". When using SSH identities, this command needs to be configured with "git config %s <hostname>" where hostname matches what is in your ssh config file.`
 
 func proposeCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addTitleFlag, readTitleFlag := flags.String("title", "t", "", "Provide a title for the proposal")
+	addBodyFlag, readBodyFlag := flags.String("body", "b", "", "Provide a body for the proposal")
+	addBodyFileFlag, readBodyFileFlag := flags.String("body-file", "f", "", "Read the proposal body from the given file (use \"-\" to read from STDIN)")
+	addDraftFlag, readDraftFlag := flags.Bool("draft", "d", "Create the proposal as a draft", flags.FlagTypeNonPersistent)
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Propose the current branch and all its ancestor branches", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:     "propose",
 		GroupID: "basic",
@@ -44,15 +56,33 @@ func proposeCommand() *cobra.Command {
 		Short:   proposeDesc,
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, gitconfig.KeyHostingPlatform, gitconfig.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executePropose(readTitleFlag(cmd), readBodyFlag(cmd), readBodyFileFlag(cmd), readDraftFlag(cmd), readStackFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addBodyFlag(&cmd)
+	addBodyFileFlag(&cmd)
+	addDraftFlag(&cmd)
 	addDryRunFlag(&cmd)
+	addStackFlag(&cmd)
+	addTitleFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executePropose(dryRun, verbose bool) error {
+func executePropose(title, body, bodyFile string, draft, stack, dryRun, verbose bool) error {
+	if stack && title != "" {
+		return errors.New(messages.ProposeStackTitle)
+	}
+	if body != "" && bodyFile != "" {
+		return errors.New(messages.ProposalBodyFlags)
+	}
+	if bodyFile != "" {
+		var err error
+		body, err = readProposalBodyFile(bodyFile)
+		if err != nil {
+			return err
+		}
+	}
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -64,7 +94,7 @@ func executePropose(dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineProposeConfig(repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineProposeConfig(repo, title, body, draft, stack, dryRun, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -99,18 +129,33 @@ func executePropose(dryRun, verbose bool) error {
 
 type proposeConfig struct {
 	*configdomain.FullConfig
-	allBranches      gitdomain.BranchInfos
-	branchesToSync   gitdomain.BranchInfos
-	connector        hostingdomain.Connector
-	dialogTestInputs components.TestInputs
-	dryRun           bool
-	hasOpenChanges   bool
-	initialBranch    gitdomain.LocalBranchName
-	previousBranch   gitdomain.LocalBranchName
-	remotes          gitdomain.Remotes
+	allBranches       gitdomain.BranchInfos
+	body              string
+	branchesToSync    gitdomain.BranchInfos
+	connector         hostingdomain.Connector
+	createViaAPI      bool // whether to create the proposal via the API of the code hosting platform instead of the browser
+	dialogTestInputs  components.TestInputs
+	draft             bool
+	dryRun            bool
+	existingProposal  *hostingdomain.Proposal // the existing proposal of the current branch when creating it via the API, nil if it has none
+	hasOpenChanges    bool
+	initialBranch     gitdomain.LocalBranchName
+	previousBranch    gitdomain.LocalBranchName
+	prototypeBranches gitdomain.LocalBranchNames // the prototype branches that become feature branches when proposing
+	remotes           gitdomain.Remotes
+	stackBranches     []proposeBranch // the branches to propose when proposing a stack
+	title             string
 }
 
-func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool) (*proposeConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+// proposeBranch describes a branch to propose as part of a stack.
+type proposeBranch struct {
+	branch   gitdomain.LocalBranchName
+	parent   gitdomain.LocalBranchName
+	proposal *hostingdomain.Proposal // the existing proposal of the branch, nil if the branch has no proposal yet
+	title    string                  // the title for the proposal to create
+}
+
+func determineProposeConfig(repo *execute.OpenRepoResult, title, body string, draft, stack, dryRun, verbose bool) (*proposeConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -141,36 +186,79 @@ func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool)
 		return nil, branchesSnapshot, stashSize, false, err
 	}
 	originURL := repo.Runner.Config.OriginURL()
-	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+	connectorArgs := hosting.NewConnectorArgs{
 		FullConfig:      &repo.Runner.Config.FullConfig,
 		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
 		Log:             print.Logger{},
 		OriginURL:       originURL,
-	})
+	}
+	connector, err := hosting.NewConnector(connectorArgs)
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
 	}
 	if connector == nil {
 		return nil, branchesSnapshot, stashSize, false, hostingdomain.UnsupportedServiceError()
 	}
+	createViaAPI := hosting.HasAPIToken(connectorArgs)
+	stackBranches := []proposeBranch{}
+	if stack {
+		if !createViaAPI {
+			return nil, branchesSnapshot, stashSize, false, errors.New(messages.ProposeStackNoAPIToken)
+		}
+		stackBranches, err = determineProposeStackBranches(repo, connector, branchesSnapshot)
+		if err != nil {
+			return nil, branchesSnapshot, stashSize, false, err
+		}
+	}
+	var existingProposal *hostingdomain.Proposal
+	if createViaAPI && !stack {
+		existingProposal, err = determineExistingProposal(repo, connector, branchesSnapshot)
+		if err != nil {
+			return nil, branchesSnapshot, stashSize, false, err
+		}
+	}
+	if createViaAPI && !stack && title == "" {
+		title, err = defaultProposalTitle(repo, branchesSnapshot.Active)
+		if err != nil {
+			return nil, branchesSnapshot, stashSize, false, err
+		}
+	}
 	branchNamesToSync := repo.Runner.Config.FullConfig.Lineage.BranchAndAncestors(branchesSnapshot.Active)
+	// proposing prototype branches turns them into feature branches so that they get pushed
+	prototypeBranches := gitdomain.LocalBranchNames{}
+	for _, branchName := range branchNamesToSync {
+		if repo.Runner.Config.FullConfig.IsPrototypeBranch(branchName) {
+			prototypeBranches = append(prototypeBranches, branchName)
+			repo.Runner.Config.FullConfig.PrototypeBranches = slice.Remove(repo.Runner.Config.FullConfig.PrototypeBranches, branchName)
+		}
+	}
 	branchesToSync, err := branchesSnapshot.Branches.Select(branchNamesToSync)
 	return &proposeConfig{
-		FullConfig:       &repo.Runner.Config.FullConfig,
-		allBranches:      branchesSnapshot.Branches,
-		branchesToSync:   branchesToSync,
-		connector:        connector,
-		dialogTestInputs: dialogTestInputs,
-		dryRun:           dryRun,
-		hasOpenChanges:   repoStatus.OpenChanges,
-		initialBranch:    branchesSnapshot.Active,
-		previousBranch:   previousBranch,
-		remotes:          remotes,
+		FullConfig:        &repo.Runner.Config.FullConfig,
+		allBranches:       branchesSnapshot.Branches,
+		body:              body,
+		branchesToSync:    branchesToSync,
+		connector:         connector,
+		createViaAPI:      createViaAPI,
+		dialogTestInputs:  dialogTestInputs,
+		draft:             draft,
+		dryRun:            dryRun,
+		existingProposal:  existingProposal,
+		hasOpenChanges:    repoStatus.OpenChanges,
+		initialBranch:     branchesSnapshot.Active,
+		previousBranch:    previousBranch,
+		prototypeBranches: prototypeBranches,
+		remotes:           remotes,
+		stackBranches:     stackBranches,
+		title:             title,
 	}, branchesSnapshot, stashSize, false, err
 }
 
 func proposeProgram(config *proposeConfig) program.Program {
 	prog := program.Program{}
+	for _, prototypeBranch := range config.prototypeBranches {
+		prog.Add(&opcodes.RemoveFromPrototypeBranches{Branch: prototypeBranch})
+	}
 	for _, branch := range config.branchesToSync {
 		sync.BranchProgram(branch, sync.BranchProgramArgs{
 			Config:        config.FullConfig,
@@ -187,14 +275,143 @@ func proposeProgram(config *proposeConfig) program.Program {
 		StashOpenChanges:         config.hasOpenChanges,
 		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
 	})
-	prog.Add(&opcodes.CreateProposal{Branch: config.initialBranch})
+	switch {
+	case !config.createViaAPI:
+		prog.Add(&opcodes.CreateProposal{Branch: config.initialBranch})
+	case len(config.stackBranches) > 0:
+		proposeStackProgram(&prog, config)
+	case config.existingProposal != nil:
+		prog.Add(&opcodes.QueueMessage{Message: fmt.Sprintf(messages.ProposalExists, config.existingProposal.URL)})
+	default:
+		prog.Add(&opcodes.ConnectorCreateProposal{
+			Body:   config.body,
+			Branch: config.initialBranch,
+			Draft:  config.draft,
+			Title:  config.title,
+		})
+	}
+	if config.createViaAPI && !config.dryRun && config.ProposalsShowStack.Bool() {
+		prog.Add(&opcodes.UpdateProposalStacks{Branches: gitdomain.LocalBranchNames{config.initialBranch}})
+	}
 	return prog
 }
 
+// proposeStackProgram adds the opcodes to create or retarget the proposals of the branches in the stack to the given program.
+func proposeStackProgram(prog *program.Program, config *proposeConfig) {
+	for _, stackBranch := range config.stackBranches {
+		switch {
+		case stackBranch.proposal == nil:
+			prog.Add(&opcodes.ConnectorCreateProposal{
+				Body:   config.body,
+				Branch: stackBranch.branch,
+				Draft:  config.draft,
+				Title:  stackBranch.title,
+			})
+		case stackBranch.proposal.Target != stackBranch.parent:
+			prog.Add(&opcodes.UpdateProposalTarget{
+				NewTarget:      stackBranch.parent,
+				ProposalNumber: stackBranch.proposal.Number,
+			})
+		}
+	}
+}
+
+// determineExistingProposal provides the proposal of the current branch against its parent branch, nil if there is none.
+func determineExistingProposal(repo *execute.OpenRepoResult, connector hostingdomain.Connector, branchesSnapshot gitdomain.BranchesSnapshot) (*hostingdomain.Proposal, error) {
+	branch := branchesSnapshot.Branches.FindByLocalName(branchesSnapshot.Active)
+	// branches without a tracking branch cannot have proposals yet
+	if branch == nil || !branch.HasTrackingBranch() {
+		return nil, nil //nolint:nilnil
+	}
+	parent := repo.Runner.Config.FullConfig.Lineage.Parent(branchesSnapshot.Active)
+	proposal, err := connector.FindProposal(branchesSnapshot.Active, parent)
+	if err != nil {
+		return nil, fmt.Errorf(messages.ProposalNotFoundForBranch, branchesSnapshot.Active, err)
+	}
+	return proposal, nil
+}
+
+// determineProposeStackBranches provides the feature branches in the stack of the current branch, oldest ancestor first,
+// together with their existing proposals.
+func determineProposeStackBranches(repo *execute.OpenRepoResult, connector hostingdomain.Connector, branchesSnapshot gitdomain.BranchesSnapshot) ([]proposeBranch, error) {
+	config := repo.Runner.Config.FullConfig
+	result := []proposeBranch{}
+	for _, branchName := range config.Lineage.BranchAndAncestors(branchesSnapshot.Active) {
+		if config.IsMainOrPerennialBranch(branchName) {
+			continue
+		}
+		if err := validateProposeBranchType(config.BranchType(branchName)); err != nil {
+			return result, err
+		}
+		branch := branchesSnapshot.Branches.FindByLocalName(branchName)
+		if branch == nil {
+			return result, fmt.Errorf(messages.BranchDoesntExist, branchName)
+		}
+		// Git Town pushes parked branches only when they are checked out,
+		// so parked ancestors must exist at the remote before their proposals can be created
+		if config.IsParkedBranch(branchName) && branchName != branchesSnapshot.Active && !branch.HasTrackingBranch() {
+			return result, fmt.Errorf(messages.ProposeStackParkedBranchNotPushed, branchName)
+		}
+		var proposal *hostingdomain.Proposal
+		// branches without a tracking branch cannot have proposals yet
+		if branch.HasTrackingBranch() {
+			var err error
+			proposal, err = connector.FindProposal(branchName, gitdomain.EmptyLocalBranchName())
+			if err != nil {
+				return result, fmt.Errorf(messages.ProposalNotFoundForBranch, branchName, err)
+			}
+		}
+		title, err := defaultProposalTitle(repo, branchName)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, proposeBranch{
+			branch:   branchName,
+			parent:   config.Lineage.Parent(branchName),
+			proposal: proposal,
+			title:    title,
+		})
+	}
+	return result, nil
+}
+
+// defaultProposalTitle provides the title for proposals of the given branch that the user didn't provide a title for:
+// the title of the first commit on the branch, or the branch name if the branch has no commits.
+func defaultProposalTitle(repo *execute.OpenRepoResult, branch gitdomain.LocalBranchName) (string, error) {
+	parent := repo.Runner.Config.FullConfig.Lineage.Parent(branch)
+	commits, err := repo.Runner.Backend.CommitsInFeatureBranch(branch, parent)
+	if err != nil || len(commits) == 0 {
+		return branch.String(), err
+	}
+	message, err := repo.Runner.Backend.CommitMessage(commits[0])
+	if err != nil {
+		return branch.String(), err
+	}
+	return commitmessage.Split(message).Title, nil
+}
+
+// readProposalBodyFile provides the content of the given file, or of STDIN if the filename is "-".
+func readProposalBodyFile(filename string) (string, error) {
+	var content []byte
+	var err error
+	if filename == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return "", fmt.Errorf(messages.ProposalBodyFileProblem, filename, err)
+	}
+	return string(content), nil
+}
+
 func validateProposeConfig(config *proposeConfig) error {
-	initialBranchType := config.FullConfig.BranchType(config.initialBranch)
-	switch initialBranchType {
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
+	return validateProposeBranchType(config.FullConfig.BranchType(config.initialBranch))
+}
+
+func validateProposeBranchType(branchType configdomain.BranchType) error {
+	switch branchType {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch:
 		return errors.New(messages.MainBranchCannotPropose)
@@ -205,5 +422,5 @@ func validateProposeConfig(config *proposeConfig) error {
 	case configdomain.BranchTypePerennialBranch:
 		return errors.New(messages.PerennialBranchCannotPropose)
 	}
-	panic(fmt.Sprintf("unhandled branch type: %v", initialBranchType))
+	panic(fmt.Sprintf("unhandled branch type: %v", branchType))
 }
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const pruneDesc = "Deletes feature branches whose changes are already in the main branch"
+
+const pruneHelp = `
+Finds local feature branches whose changes are already contained in the main branch,
+for example because they were squash-merged via the web UI of your code hosting platform.
+Lets you select which of these branches to delete
+and removes them from the local and origin repositories.`
+
+func pruneCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	cmd := cobra.Command{
+		Use:   "prune",
+		Args:  cobra.NoArgs,
+		Short: pruneDesc,
+		Long:  cmdhelpers.Long(pruneDesc, pruneHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executePrune(readDryRunFlag(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executePrune(dryRun, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determinePruneConfig(repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	steps, finalUndoProgram := killProgram(config)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "prune",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            steps,
+		FinalUndoProgram:      finalUndoProgram,
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               nil,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          config.hasOpenChanges,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+// determinePruneConfig provides the configuration to kill the branches that the user selected for pruning.
+func determinePruneConfig(repo *execute.OpenRepoResult, dryRun, verbose bool) (*killConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	candidates, err := pruneCandidates(repo, branchesSnapshot.Branches)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	if len(candidates) == 0 {
+		fmt.Println(messages.PruneNoBranches)
+		return nil, branchesSnapshot, stashSize, true, nil
+	}
+	selectedBranches, aborted, err := dialog.PruneBranches(candidates, dialogTestInputs.Next())
+	if err != nil || aborted || len(selectedBranches) == 0 {
+		return nil, branchesSnapshot, stashSize, true, err
+	}
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	sortDescendantsFirst(selectedBranches, lineage)
+	branchesToKill := make(gitdomain.BranchInfos, 0, len(selectedBranches))
+	for _, selectedBranch := range selectedBranches {
+		branchesToKill = append(branchesToKill, *branchesSnapshot.Branches.FindByLocalName(selectedBranch))
+	}
+	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
+	branchWhenDone := branchesSnapshot.Active
+	if selectedBranches.Contains(branchesSnapshot.Active) {
+		branchWhenDone = killBranchWhenDone(branchesSnapshot.Active, previousBranch, selectedBranches, repo.Runner.Config.FullConfig)
+	}
+	return &killConfig{
+		FullConfig:       &repo.Runner.Config.FullConfig,
+		branchWhenDone:   branchWhenDone,
+		branchesToKill:   branchesToKill,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		initialBranch:    branchesSnapshot.Active,
+		previousBranch:   previousBranch,
+	}, branchesSnapshot, stashSize, false, nil
+}
+
+// pruneCandidates provides the local feature branches whose changes are already in the main branch.
+func pruneCandidates(repo *execute.OpenRepoResult, branches gitdomain.BranchInfos) (gitdomain.LocalBranchNames, error) {
+	config := repo.Runner.Config.FullConfig
+	mainBranch := branches.FindByLocalName(config.MainBranch)
+	if mainBranch == nil {
+		return gitdomain.LocalBranchNames{}, fmt.Errorf(messages.BranchDoesntExist, config.MainBranch)
+	}
+	// compare against the tracking branch of main because it contains the latest squash merges
+	target := mainBranch.LocalName.BranchName()
+	if mainBranch.HasTrackingBranch() {
+		target = mainBranch.RemoteName.BranchName()
+	}
+	result := gitdomain.LocalBranchNames{}
+	for _, branch := range branches.LocalBranches() {
+		switch config.BranchType(branch.LocalName) {
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePerennialBranch:
+			continue
+		}
+		if branch.SyncStatus == gitdomain.SyncStatusOtherWorktree {
+			continue
+		}
+		// branches that don't contain commits yet have nothing to prune
+		parent := branches.FindByLocalName(config.Lineage.Parent(branch.LocalName))
+		if parent != nil && parent.LocalSHA == branch.LocalSHA {
+			continue
+		}
+		merged, err := repo.Runner.Backend.BranchChangesMergedInto(branch.LocalName, target)
+		if err != nil {
+			return result, err
+		}
+		if merged {
+			result = append(result, branch.LocalName)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+)
+
+// rebaseBranchOnto adds the opcodes to move the commits of the given branch after the given location
+// onto the given new base to the given program.
+func rebaseBranchOnto(prog *program.Program, branch gitdomain.BranchInfo, newBase gitdomain.BranchName, commitsToRemove gitdomain.Location, online bool) {
+	prog.Add(&opcodes.Checkout{Branch: branch.LocalName})
+	prog.Add(&opcodes.RebaseOnto{
+		BranchToRebaseAgainst: newBase,
+		CommitsToRemove:       commitsToRemove,
+	})
+	if branch.HasTrackingBranch() && online {
+		prog.Add(&opcodes.ForcePushCurrentBranch{})
+	}
+}
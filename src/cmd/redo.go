@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo"
+	"github.com/git-town/git-town/v12/src/undo/undobranches"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v12/src/vm/program"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/git-town/git-town/v12/src/vm/statefile"
+	"github.com/spf13/cobra"
+)
+
+const redoDesc = "Re-applies the most recently undone Git Town command"
+
+const redoHelp = `
+Reverts the most recent "git town undo".
+This only works if no other Git Town command ran since the undo
+and the branches and Git Town configuration haven't changed since then.`
+
+func redoCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "redo",
+		GroupID: "errors",
+		Args:    cobra.NoArgs,
+		Short:   redoDesc,
+		Long:    cmdhelpers.Long(redoDesc, redoHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeRedo(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeRedo(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineRedoConfig(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "redo",
+		DryRun:                false,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            redoProgram(config, repo),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               nil,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          config.hasOpenChanges,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type redoConfig struct {
+	*configdomain.FullConfig
+	dialogTestInputs components.TestInputs
+	hasOpenChanges   bool
+	undoRunState     runstate.RunState // describes how the undo to redo changed the repo
+}
+
+func determineRedoConfig(repo *execute.OpenRepoResult, verbose bool) (*redoConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 false,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	undoRunState, err := statefile.LoadRedo(repo.RootDir)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.RunstateLoadProblem, err)
+	}
+	if undoRunState == nil {
+		fmt.Println(messages.RedoNothingToDo)
+		return nil, branchesSnapshot, stashSize, true, nil
+	}
+	// redoing on top of changes made after the undo would discard these changes
+	changedBranches := undobranches.NewBranchSpans(undoRunState.EndBranchesSnapshot, branchesSnapshot).ChangedBranches()
+	if len(changedBranches) > 0 {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.RedoBranchesChanged, changedBranches.Join(", "))
+	}
+	if !undoconfig.NewConfigDiffs(undoRunState.EndConfigSnapshot, repo.ConfigSnapshot).IsEmpty() {
+		return nil, branchesSnapshot, stashSize, false, errors.New(messages.RedoConfigChanged)
+	}
+	return &redoConfig{
+		FullConfig:       &repo.Runner.Config.FullConfig,
+		dialogTestInputs: dialogTestInputs,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		undoRunState:     *undoRunState,
+	}, branchesSnapshot, stashSize, false, nil
+}
+
+// redoProgram provides the program that reverses the changes the undo made to the repo.
+func redoProgram(config *redoConfig, repo *execute.OpenRepoResult) program.Program {
+	return undo.CreateUndoForFinishedProgram(undo.CreateUndoProgramArgs{
+		DryRun:         false,
+		HasOpenChanges: config.hasOpenChanges,
+		NoPushHook:     config.NoPushHook(),
+		Run:            repo.Runner,
+		RunState:       config.undoRunState,
+	})
+}
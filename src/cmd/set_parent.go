@@ -9,6 +9,8 @@ import (
 	"github.com/git-town/git-town/v12/src/cli/print"
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting"
 	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/spf13/cobra"
 )
@@ -78,6 +80,36 @@ func executeSetParent(verbose bool) error {
 	if err != nil {
 		return err
 	}
+	err = updateProposalStacksAfterSetParent(repo, branchesSnapshot.Active, existingParent)
+	if err != nil {
+		return err
+	}
 	print.Footer(verbose, repo.Runner.CommandsCounter.Count(), print.NoFinalMessages)
 	return nil
 }
+
+// updateProposalStacksAfterSetParent updates the stack tables in the proposals of the old and new stack of the given branch.
+func updateProposalStacksAfterSetParent(repo *execute.OpenRepoResult, branch, oldParent gitdomain.LocalBranchName) error {
+	config := &repo.Runner.Config.FullConfig
+	if !config.ProposalsShowStack.Bool() || repo.IsOffline.Bool() {
+		return nil
+	}
+	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		FullConfig:      config,
+		HostingPlatform: config.HostingPlatform,
+		Log:             print.Logger{},
+		OriginURL:       repo.Runner.Config.OriginURL(),
+	})
+	if err != nil || connector == nil {
+		return err
+	}
+	branches := gitdomain.LocalBranchNames{branch}
+	if !config.IsMainOrPerennialBranch(oldParent) {
+		branches = append(branches, oldParent)
+	}
+	return hosting.UpdateProposalStacks(hosting.UpdateProposalStacksArgs{
+		Branches:  branches,
+		Config:    config,
+		Connector: connector,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 
 	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/cli/flags"
@@ -41,8 +42,17 @@ Squash-merges the current branch, or <branch_name> if given, into the main branc
 - pushes the main branch to the origin repository
 - deletes <branch_name> from the local and origin repositories
 
+The "ship-strategy" setting determines how Git Town merges the branch into the main branch:
+- "squash-merge" (default) creates a single squash commit
+- "fast-forward" fast-forwards the main branch to the branch, which requires the branch to be in sync with the main branch
+- "always-merge" creates a merge commit even if the main branch could be fast-forwarded
+
 Ships direct children of the main branch. To ship a child branch, ship or kill all ancestor branches first.
 
+With the --stack switch, ships the current branch, or <branch_name> if given, together with all its ancestor branches.
+Ships the branches one after the other, starting with the branch closest to the main branch.
+Before shipping the next branch, syncs it with the main branch.
+
 If you use GitHub, this command can squash merge pull requests via the GitHub API. Setup:
 
 1. Get a GitHub personal access token with the "repo" scope
@@ -54,8 +64,9 @@ If your origin server deletes shipped branches, for example GitHub's feature to
 
 func shipCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
-	addMessageFlag, readMessageFlag := flags.String("message", "m", "", "Specify the commit message for the squash commit")
+	addMessageFlag, readMessageFlag := flags.String("message", "m", "", "Specify the commit message for the squash or merge commit")
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Ship the branch and all its ancestor branches", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:     "ship",
 		GroupID: "basic",
@@ -63,16 +74,20 @@ func shipCmd() *cobra.Command {
 		Short:   shipDesc,
 		Long:    cmdhelpers.Long(shipDesc, fmt.Sprintf(shipHelp, gitconfig.KeyGithubToken, gitconfig.KeyShipDeleteTrackingBranch)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeShip(args, readMessageFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeShip(args, readMessageFlag(cmd), readStackFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addMessageFlag(&cmd)
+	addStackFlag(&cmd)
 	return &cmd
 }
 
-func executeShip(args []string, message string, dryRun, verbose bool) error {
+func executeShip(args []string, message string, stack, dryRun, verbose bool) error {
+	if stack && message != "" {
+		return errors.New(messages.ShipStackMessage)
+	}
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -84,11 +99,11 @@ func executeShip(args []string, message string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineShipConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineShipConfig(args, repo, stack, dryRun, verbose)
 	if err != nil || exit {
 		return err
 	}
-	if config.branchToShip.LocalName == config.initialBranch {
+	if config.isShippingInitialBranch {
 		repoStatus, err := repo.Runner.Backend.RepoStatus()
 		if err != nil {
 			return err
@@ -108,6 +123,7 @@ func executeShip(args []string, message string, dryRun, verbose bool) error {
 		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
 		EndStashSize:          0,
 		RunProgram:            shipProgram(config, message),
+		FinalUndoProgram:      shipFinalUndoProgram(config),
 	}
 	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
 		Connector:               config.connector,
@@ -126,25 +142,41 @@ func executeShip(args []string, message string, dryRun, verbose bool) error {
 
 type shipConfig struct {
 	*configdomain.FullConfig
-	allBranches              gitdomain.BranchInfos
-	branchToShip             gitdomain.BranchInfo
+	allBranches             gitdomain.BranchInfos
+	branchesToShip          []shipBranch // the branches to ship, in the order in which to ship them
+	connector               hostingdomain.Connector
+	dialogTestInputs        components.TestInputs
+	dryRun                  bool
+	hasOpenChanges          bool
+	initialBranch           gitdomain.LocalBranchName
+	isShippingInitialBranch bool
+	previousBranch          gitdomain.LocalBranchName
+	remotes                 gitdomain.Remotes
+	targetBranch            gitdomain.BranchInfo
+}
+
+// hasRemainingChildren indicates whether the given branch has child branches that don't get shipped together with it.
+func (self *shipConfig) hasRemainingChildren(branch shipBranch) bool {
+	for _, child := range branch.childBranches {
+		if !slices.ContainsFunc(self.branchesToShip, func(branchToShip shipBranch) bool { return branchToShip.branch.LocalName == child }) {
+			return true
+		}
+	}
+	return false
+}
+
+// shipBranch describes a single branch to ship.
+type shipBranch struct {
+	branch                   gitdomain.BranchInfo
 	canShipViaAPI            bool
 	childBranches            gitdomain.LocalBranchNames
-	connector                hostingdomain.Connector
-	dialogTestInputs         components.TestInputs
-	dryRun                   bool
-	hasOpenChanges           bool
-	initialBranch            gitdomain.LocalBranchName
-	isShippingInitialBranch  bool
-	previousBranch           gitdomain.LocalBranchName
+	parent                   gitdomain.LocalBranchName // the parent of the branch before shipping
 	proposal                 *hostingdomain.Proposal
 	proposalMessage          string
 	proposalsOfChildBranches []hostingdomain.Proposal
-	remotes                  gitdomain.Remotes
-	targetBranch             gitdomain.BranchInfo
 }
 
-func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*shipConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineShipConfig(args []string, repo *execute.OpenRepoResult, stack, dryRun, verbose bool) (*shipConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -169,8 +201,7 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	if branchToShip != nil && branchToShip.SyncStatus == gitdomain.SyncStatusOtherWorktree {
 		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ShipBranchOtherWorktree, branchNameToShip)
 	}
-	isShippingInitialBranch := branchNameToShip == branchesSnapshot.Active
-	if !isShippingInitialBranch {
+	if branchNameToShip != branchesSnapshot.Active {
 		if branchToShip == nil {
 			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToShip)
 		}
@@ -188,18 +219,22 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
 	}
-	err = ensureParentBranchIsMainOrPerennialBranch(branchNameToShip, &repo.Runner.Config.FullConfig, repo.Runner.Config.FullConfig.Lineage)
-	if err != nil {
-		return nil, branchesSnapshot, stashSize, false, err
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	branchNamesToShip := gitdomain.LocalBranchNames{branchNameToShip}
+	if stack {
+		// the oldest ancestor is the main or perennial branch that the stack gets shipped into
+		branchNamesToShip = lineage.BranchAndAncestors(branchNameToShip)[1:]
+	} else {
+		err = ensureParentBranchIsMainOrPerennialBranch(branchNameToShip, &repo.Runner.Config.FullConfig, lineage)
+		if err != nil {
+			return nil, branchesSnapshot, stashSize, false, err
+		}
 	}
-	targetBranchName := repo.Runner.Config.FullConfig.Lineage.Parent(branchNameToShip)
+	targetBranchName := lineage.Parent(branchNamesToShip[0])
 	targetBranch := branchesSnapshot.Branches.FindByLocalName(targetBranchName)
 	if targetBranch == nil {
 		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, targetBranchName)
 	}
-	var proposal *hostingdomain.Proposal
-	childBranches := repo.Runner.Config.FullConfig.Lineage.Children(branchNameToShip)
-	proposalsOfChildBranches := []hostingdomain.Proposal{}
 	originURL := repo.Runner.Config.OriginURL()
 	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
 		FullConfig:      &repo.Runner.Config.FullConfig,
@@ -210,47 +245,69 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
 	}
-	canShipViaAPI := false
-	proposalMessage := ""
-	if !repo.IsOffline && connector != nil {
-		if branchToShip.HasTrackingBranch() {
-			proposal, err = connector.FindProposal(branchNameToShip, targetBranchName)
-			if err != nil {
-				return nil, branchesSnapshot, stashSize, false, err
-			}
-			if proposal != nil {
-				canShipViaAPI = true
-				proposalMessage = connector.DefaultProposalMessage(*proposal)
-			}
+	branchesToShip := make([]shipBranch, 0, len(branchNamesToShip))
+	for _, branchName := range branchNamesToShip {
+		branchInfo := branchesSnapshot.Branches.FindByLocalName(branchName)
+		if branchInfo == nil {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchName)
 		}
-		for _, childBranch := range childBranches {
-			childProposal, err := connector.FindProposal(childBranch, branchNameToShip)
-			if err != nil {
-				return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ProposalNotFoundForBranch, branchNameToShip, err)
+		if err = validateShippableBranchType(repo.Runner.Config.FullConfig.BranchType(branchName)); err != nil {
+			return nil, branchesSnapshot, stashSize, false, err
+		}
+		parent := lineage.Parent(branchName)
+		childBranches := lineage.Children(branchName)
+		var proposal *hostingdomain.Proposal
+		proposalsOfChildBranches := []hostingdomain.Proposal{}
+		canShipViaAPI := false
+		proposalMessage := ""
+		if !repo.IsOffline && connector != nil {
+			if branchInfo.HasTrackingBranch() {
+				proposal, err = connector.FindProposal(branchName, parent)
+				if err != nil {
+					return nil, branchesSnapshot, stashSize, false, err
+				}
+				if proposal != nil {
+					canShipViaAPI = true
+					proposalMessage = connector.DefaultProposalMessage(*proposal)
+				}
 			}
-			if childProposal != nil {
-				proposalsOfChildBranches = append(proposalsOfChildBranches, *childProposal)
+			for _, childBranch := range childBranches {
+				if branchNamesToShip.Contains(childBranch) {
+					// the proposals of branches to ship get updated right before they get shipped
+					continue
+				}
+				childProposal, err := connector.FindProposal(childBranch, branchName)
+				if err != nil {
+					return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ProposalNotFoundForBranch, branchName, err)
+				}
+				if childProposal != nil {
+					proposalsOfChildBranches = append(proposalsOfChildBranches, *childProposal)
+				}
 			}
 		}
+		branchesToShip = append(branchesToShip, shipBranch{
+			branch:                   *branchInfo,
+			canShipViaAPI:            canShipViaAPI,
+			childBranches:            childBranches,
+			parent:                   parent,
+			proposal:                 proposal,
+			proposalMessage:          proposalMessage,
+			proposalsOfChildBranches: proposalsOfChildBranches,
+		})
 	}
 	return &shipConfig{
-		FullConfig:               &repo.Runner.Config.FullConfig,
-		allBranches:              branchesSnapshot.Branches,
-		branchToShip:             *branchToShip,
-		canShipViaAPI:            canShipViaAPI,
-		childBranches:            childBranches,
-		connector:                connector,
-		dialogTestInputs:         dialogTestInputs,
-		dryRun:                   dryRun,
-		hasOpenChanges:           repoStatus.OpenChanges,
-		initialBranch:            branchesSnapshot.Active,
-		isShippingInitialBranch:  isShippingInitialBranch,
-		previousBranch:           previousBranch,
-		proposal:                 proposal,
-		proposalMessage:          proposalMessage,
-		proposalsOfChildBranches: proposalsOfChildBranches,
-		remotes:                  remotes,
-		targetBranch:             *targetBranch,
+		FullConfig:              &repo.Runner.Config.FullConfig,
+		allBranches:             branchesSnapshot.Branches,
+		branchesToShip:          branchesToShip,
+		connector:               connector,
+		dialogTestInputs:        dialogTestInputs,
+		dryRun:                  dryRun,
+		hasOpenChanges:          repoStatus.OpenChanges,
+		initialBranch:           branchesSnapshot.Active,
+		isShippingInitialBranch: branchNamesToShip.Contains(branchesSnapshot.Active),
+		previousBranch:          previousBranch,
+		remotes:                 remotes,
+		targetBranch:            *targetBranch,
 	}, branchesSnapshot, stashSize, false, nil
 }
 
@@ -267,46 +324,134 @@ func ensureParentBranchIsMainOrPerennialBranch(branch gitdomain.LocalBranchName,
 
 func shipProgram(config *shipConfig, commitMessage string) program.Program {
 	prog := program.Program{}
-	if config.SyncBeforeShip {
-		// sync the parent branch
-		sync.BranchProgram(config.targetBranch, sync.BranchProgramArgs{
-			Config:        config.FullConfig,
-			BranchInfos:   config.allBranches,
-			InitialBranch: config.initialBranch,
-			Remotes:       config.remotes,
-			Program:       &prog,
-			PushBranch:    true,
-		})
-		// sync the branch to ship (local sync only)
-		sync.BranchProgram(config.branchToShip, sync.BranchProgramArgs{
-			Config:        config.FullConfig,
-			BranchInfos:   config.allBranches,
-			InitialBranch: config.initialBranch,
-			Remotes:       config.remotes,
-			Program:       &prog,
-			PushBranch:    false,
-		})
+	for i, branchToShip := range config.branchesToShip {
+		if i == 0 {
+			if config.SyncBeforeShip {
+				// sync the parent branch
+				sync.BranchProgram(config.targetBranch, sync.BranchProgramArgs{
+					Config:        config.FullConfig,
+					BranchInfos:   config.allBranches,
+					InitialBranch: config.initialBranch,
+					Remotes:       config.remotes,
+					Program:       &prog,
+					PushBranch:    true,
+				})
+				// sync the branch to ship (local sync only)
+				sync.BranchProgram(branchToShip.branch, sync.BranchProgramArgs{
+					Config:        config.FullConfig,
+					BranchInfos:   config.allBranches,
+					InitialBranch: config.initialBranch,
+					Remotes:       config.remotes,
+					Program:       &prog,
+					PushBranch:    false,
+				})
+			}
+		} else {
+			// the previously shipped branch is now part of the target branch,
+			// sync the next branch with the target branch so that it contains only its own changes
+			sync.BranchProgram(branchToShip.branch, sync.BranchProgramArgs{
+				Config:        config.FullConfig,
+				BranchInfos:   config.allBranches,
+				InitialBranch: config.initialBranch,
+				Remotes:       config.remotes,
+				Program:       &prog,
+				PushBranch:    branchToShip.canShipViaAPI,
+			})
+		}
+		shipBranchProgram(&prog, branchToShip, config, commitMessage)
+	}
+	if !config.isShippingInitialBranch {
+		prog.Add(&opcodes.Checkout{Branch: config.initialBranch})
+	}
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   config.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         !config.isShippingInitialBranch && config.hasOpenChanges,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
+	})
+	if config.ProposalsShowStack.Bool() && config.connector != nil && config.IsOnline() && !config.dryRun {
+		// the stacks of the remaining child branches no longer contain the shipped branches
+		remainingChildren := gitdomain.LocalBranchNames{}
+		for _, branchToShip := range config.branchesToShip {
+			for _, child := range branchToShip.childBranches {
+				if !slices.ContainsFunc(config.branchesToShip, func(shipped shipBranch) bool { return shipped.branch.LocalName == child }) {
+					remainingChildren = append(remainingChildren, child)
+				}
+			}
+		}
+		if len(remainingChildren) > 0 {
+			prog.Add(&opcodes.UpdateProposalStacks{Branches: remainingChildren})
+		}
+	}
+	return prog
+}
+
+// shipFinalUndoProgram provides the opcodes to run at the end of undoing the ship command.
+func shipFinalUndoProgram(config *shipConfig) program.Program {
+	result := program.Program{}
+	if config.ShipStrategy != configdomain.ShipStrategyFastForward {
+		return result
 	}
-	prog.Add(&opcodes.EnsureHasShippableChanges{Branch: config.branchToShip.LocalName, Parent: config.MainBranch})
+	for _, branchToShip := range config.branchesToShip {
+		if !branchToShip.canShipViaAPI {
+			// fast-forwarding doesn't create a commit on the target branch that undo could revert
+			result.Add(&opcodes.QueueMessage{Message: fmt.Sprintf(messages.ShipFastForwardUndo, config.targetBranch.LocalName)})
+			break
+		}
+	}
+	return result
+}
+
+// shipBranchProgram adds the opcodes to ship the given branch into the target branch to the given program.
+func shipBranchProgram(prog *program.Program, branchToShip shipBranch, config *shipConfig, commitMessage string) {
+	prog.Add(&opcodes.EnsureHasShippableChanges{Branch: branchToShip.branch.LocalName, Parent: config.MainBranch})
 	prog.Add(&opcodes.Checkout{Branch: config.targetBranch.LocalName})
-	if config.canShipViaAPI {
+	if branchToShip.canShipViaAPI {
 		// update the proposals of child branches
-		for _, childProposal := range config.proposalsOfChildBranches {
+		for _, childProposal := range branchToShip.proposalsOfChildBranches {
 			prog.Add(&opcodes.UpdateProposalTarget{
 				ProposalNumber: childProposal.Number,
 				NewTarget:      config.targetBranch.LocalName,
 			})
 		}
-		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: config.branchToShip.LocalName})
-		prog.Add(&opcodes.ConnectorMergeProposal{
-			Branch:          config.branchToShip.LocalName,
-			ProposalNumber:  config.proposal.Number,
-			CommitMessage:   commitMessage,
-			ProposalMessage: config.proposalMessage,
-		})
+		// the proposal of a stacked branch still targets the already shipped parent branch
+		if branchToShip.parent != config.targetBranch.LocalName {
+			prog.Add(&opcodes.UpdateProposalTarget{
+				ProposalNumber: branchToShip.proposal.Number,
+				NewTarget:      config.targetBranch.LocalName,
+			})
+		}
+		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: branchToShip.branch.LocalName})
+		switch config.ShipStrategy {
+		case configdomain.ShipStrategySquashMerge:
+			prog.Add(&opcodes.ConnectorMergeProposal{
+				Branch:          branchToShip.branch.LocalName,
+				ProposalNumber:  branchToShip.proposal.Number,
+				CommitMessage:   commitMessage,
+				ProposalMessage: branchToShip.proposalMessage,
+			})
+		case configdomain.ShipStrategyFastForward:
+			prog.Add(&opcodes.ConnectorFastForwardProposal{
+				Branch:         branchToShip.branch.LocalName,
+				ProposalNumber: branchToShip.proposal.Number,
+			})
+		case configdomain.ShipStrategyAlwaysMerge:
+			prog.Add(&opcodes.ConnectorMergeCommitProposal{
+				Branch:         branchToShip.branch.LocalName,
+				CommitMessage:  commitMessage,
+				ProposalNumber: branchToShip.proposal.Number,
+			})
+		}
 		prog.Add(&opcodes.PullCurrentBranch{})
 	} else {
-		prog.Add(&opcodes.SquashMerge{Branch: config.branchToShip.LocalName, CommitMessage: commitMessage, Parent: config.targetBranch.LocalName})
+		switch config.ShipStrategy {
+		case configdomain.ShipStrategySquashMerge:
+			prog.Add(&opcodes.SquashMerge{Branch: branchToShip.branch.LocalName, CommitMessage: commitMessage, Parent: config.targetBranch.LocalName})
+		case configdomain.ShipStrategyFastForward:
+			prog.Add(&opcodes.FastForwardMerge{Branch: branchToShip.branch.LocalName})
+		case configdomain.ShipStrategyAlwaysMerge:
+			prog.Add(&opcodes.CreateMergeCommit{Branch: branchToShip.branch.LocalName, CommitMessage: commitMessage})
+		}
 	}
 	if config.remotes.HasOrigin() && config.IsOnline() {
 		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: config.targetBranch.LocalName})
@@ -315,28 +460,18 @@ func shipProgram(config *shipConfig, commitMessage string) program.Program {
 	// - we know we have a tracking branch (otherwise there would be no PR to ship via API)
 	// - we have updated the PRs of all child branches (because we have API access)
 	// - we know we are online
-	if config.canShipViaAPI || (config.branchToShip.HasTrackingBranch() && len(config.childBranches) == 0 && config.IsOnline()) {
+	if branchToShip.canShipViaAPI || (branchToShip.branch.HasTrackingBranch() && !config.hasRemainingChildren(branchToShip) && config.IsOnline()) {
 		if config.ShipDeleteTrackingBranch {
-			prog.Add(&opcodes.DeleteTrackingBranch{Branch: config.branchToShip.RemoteName})
+			prog.Add(&opcodes.DeleteTrackingBranch{Branch: branchToShip.branch.RemoteName})
 		}
 	}
-	prog.Add(&opcodes.DeleteLocalBranch{Branch: config.branchToShip.LocalName})
+	prog.Add(&opcodes.DeleteLocalBranch{Branch: branchToShip.branch.LocalName})
 	if !config.dryRun {
-		prog.Add(&opcodes.DeleteParentBranch{Branch: config.branchToShip.LocalName})
+		prog.Add(&opcodes.DeleteParentBranch{Branch: branchToShip.branch.LocalName})
 	}
-	for _, child := range config.childBranches {
+	for _, child := range branchToShip.childBranches {
 		prog.Add(&opcodes.ChangeParent{Branch: child, Parent: config.targetBranch.LocalName})
 	}
-	if !config.isShippingInitialBranch {
-		prog.Add(&opcodes.Checkout{Branch: config.initialBranch})
-	}
-	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
-		DryRun:                   config.dryRun,
-		RunInGitRoot:             true,
-		StashOpenChanges:         !config.isShippingInitialBranch && config.hasOpenChanges,
-		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
-	})
-	return prog
 }
 
 func validateShippableBranchType(branchType configdomain.BranchType) error {
@@ -351,6 +486,8 @@ func validateShippableBranchType(branchType configdomain.BranchType) error {
 		return errors.New(messages.ObservedBranchCannotShip)
 	case configdomain.BranchTypePerennialBranch:
 		return errors.New(messages.PerennialBranchCannotShip)
+	case configdomain.BranchTypePrototypeBranch:
+		return errors.New(messages.PrototypeBranchCannotShip)
 	}
 	panic(fmt.Sprintf("unhandled branch type: %v", branchType))
 }
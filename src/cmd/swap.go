@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const swapDesc = "Swaps the position of the current branch with its parent branch"
+
+const swapHelp = `
+Moves the current branch one position down in the stack, i.e. makes it the parent of its current parent branch.
+Rebases both branches onto their new parent branches, updates the parents of their child branches, and force-pushes the changed branches.
+
+Both branches must be feature branches that are in sync with their parent and tracking branches.`
+
+func swapCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	cmd := cobra.Command{
+		Use:     "swap",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   swapDesc,
+		Long:    cmdhelpers.Long(swapDesc, swapHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeSwap(readDryRunFlag(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeSwap(dryRun, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineSwapConfig(repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "swap",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            swapProgram(config),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               nil,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          false,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type swapConfig struct {
+	*configdomain.FullConfig
+	children         gitdomain.BranchInfos // the children of the current branch
+	current          gitdomain.BranchInfo
+	dialogTestInputs components.TestInputs
+	dryRun           bool
+	grandParent      gitdomain.LocalBranchName
+	parent           gitdomain.BranchInfo
+	previousBranch   gitdomain.LocalBranchName
+	siblings         gitdomain.BranchInfos // the other children of the parent branch
+}
+
+func determineSwapConfig(repo *execute.OpenRepoResult, dryRun, verbose bool) (*swapConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	fc := execute.FailureCollector{}
+	branchesSnapshot, stashSize, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: true,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	err = execute.EnsureKnownBranchAncestry(branchesSnapshot.Active, execute.EnsureKnownBranchAncestryArgs{
+		Config:           &repo.Runner.Config.FullConfig,
+		AllBranches:      branchesSnapshot.Branches,
+		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+		DialogTestInputs: &dialogTestInputs,
+		Runner:           repo.Runner,
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	current := branchesSnapshot.Branches.FindByLocalName(branchesSnapshot.Active)
+	if current == nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchesSnapshot.Active)
+	}
+	parentName := lineage.Parent(current.LocalName)
+	branchNamesToSwap := gitdomain.LocalBranchNames{current.LocalName, parentName}
+	for _, branchName := range branchNamesToSwap {
+		branchType := repo.Runner.Config.FullConfig.BranchType(branchName)
+		if !branchType.IsRewritable() {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.SwapBranchType, branchType, branchName)
+		}
+	}
+	parent := branchesSnapshot.Branches.FindByLocalName(parentName)
+	if parent == nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, parentName)
+	}
+	grandParent := lineage.Parent(parentName)
+	branchesToSwap := gitdomain.BranchInfos{*current, *parent}
+	for _, branch := range branchesToSwap {
+		switch branch.SyncStatus {
+		case gitdomain.SyncStatusDeletedAtRemote, gitdomain.SyncStatusNotInSync, gitdomain.SyncStatusOtherWorktree, gitdomain.SyncStatusRemoteOnly:
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchNotInSync, branch.LocalName)
+		case gitdomain.SyncStatusLocalOnly, gitdomain.SyncStatusUpToDate:
+		}
+		if !repo.Runner.Backend.BranchInSyncWithParent(branch.LocalName, lineage.Parent(branch.LocalName)) {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchNotInSync, branch.LocalName)
+		}
+	}
+	siblingNames := lineage.Children(parentName)
+	siblingNames = siblingNames.Remove(current.LocalName)
+	return &swapConfig{
+		FullConfig:       &repo.Runner.Config.FullConfig,
+		children:         fc.BranchInfos(branchesSnapshot.Branches.Select(lineage.Children(current.LocalName))),
+		current:          *current,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		grandParent:      grandParent,
+		parent:           *parent,
+		previousBranch:   repo.Runner.Backend.PreviouslyCheckedOutBranch(),
+		siblings:         fc.BranchInfos(branchesSnapshot.Branches.Select(siblingNames)),
+	}, branchesSnapshot, stashSize, false, fc.Err
+}
+
+func swapProgram(config *swapConfig) program.Program {
+	prog := program.Program{}
+	// move the current branch onto the grandparent branch
+	rebaseBranchOnto(&prog, config.current, config.grandParent.BranchName(), config.parent.LocalName.Location(), config.IsOnline())
+	// move the parent branch onto the current branch
+	rebaseBranchOnto(&prog, config.parent, config.current.LocalName.BranchName(), config.grandParent.Location(), config.IsOnline())
+	// move the children of the current branch onto the parent branch
+	for _, child := range config.children {
+		rebaseBranchOnto(&prog, child, config.parent.LocalName.BranchName(), config.current.LocalSHA.Location(), config.IsOnline())
+	}
+	// move the other children of the parent branch onto the updated parent branch
+	for _, sibling := range config.siblings {
+		rebaseBranchOnto(&prog, sibling, config.parent.LocalName.BranchName(), config.parent.LocalSHA.Location(), config.IsOnline())
+	}
+	prog.Add(&opcodes.ChangeParent{Branch: config.current.LocalName, Parent: config.grandParent})
+	prog.Add(&opcodes.ChangeParent{Branch: config.parent.LocalName, Parent: config.current.LocalName})
+	for _, child := range config.children {
+		prog.Add(&opcodes.ChangeParent{Branch: child.LocalName, Parent: config.parent.LocalName})
+	}
+	prog.Add(&opcodes.Checkout{Branch: config.current.LocalName})
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   config.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         false,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
+	})
+	return prog
+}
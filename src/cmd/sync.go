@@ -1,19 +1,25 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cli/print"
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/config/gitconfig"
 	"github.com/git-town/git-town/v12/src/execute"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/git-town/git-town/v12/src/sync"
 	"github.com/git-town/git-town/v12/src/undo/undoconfig"
 	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
 	"github.com/git-town/git-town/v12/src/vm/program"
 	"github.com/git-town/git-town/v12/src/vm/runstate"
 	"github.com/spf13/cobra"
@@ -34,29 +40,45 @@ When run on the main branch or a perennial branch:
 - pulls and pushes updates for the current branch
 - pushes tags
 
+If you provide branch names, syncs these branches and their ancestors instead of the current branch.
+With the --stack switch, also syncs all descendants of the current or provided branches.
+With the --all switch, syncs all local branches.
+With the --no-push switch, doesn't push the synced branches and tags.
+You can disable pushing permanently by running "git config %s false".
+
 If the repository contains an "upstream" remote, syncs the main branch with its upstream counterpart. You can disable this by running "git config %s false".`
 
 func syncCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	addAllFlag, readAllFlag := flags.Bool("all", "a", "Sync all local branches", flags.FlagTypeNonPersistent)
+	addNoPushFlag, readNoPushFlag := flags.Bool("no-push", "", "Do not push local branches", flags.FlagTypeNonPersistent)
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Sync all branches of the current stack", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
-		Use:     "sync",
+		Use:     "sync [--all | --stack] [<branch>...]",
 		GroupID: "basic",
-		Args:    cobra.NoArgs,
+		Args:    cobra.ArbitraryArgs,
 		Short:   syncDesc,
-		Long:    cmdhelpers.Long(syncDesc, fmt.Sprintf(syncHelp, gitconfig.KeySyncUpstream)),
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeSync(readAllFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
+		Long:    cmdhelpers.Long(syncDesc, fmt.Sprintf(syncHelp, gitconfig.KeySyncPush, gitconfig.KeySyncUpstream)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeSync(args, readAllFlag(cmd), readStackFlag(cmd), readNoPushFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addAllFlag(&cmd)
+	addNoPushFlag(&cmd)
+	addStackFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addDryRunFlag(&cmd)
 	return &cmd
 }
 
-func executeSync(all, dryRun, verbose bool) error {
+func executeSync(args []string, all, stack, noPush, dryRun, verbose bool) error {
+	if all && stack {
+		return errors.New(messages.SyncAllAndStack)
+	}
+	if all && len(args) > 0 {
+		return errors.New(messages.SyncAllAndBranches)
+	}
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -68,10 +90,11 @@ func executeSync(all, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineSyncConfig(all, repo, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineSyncConfig(args, all, stack, repo, verbose)
 	if err != nil || exit {
 		return err
 	}
+	pushBranches := !noPush && config.SyncPush.Bool()
 	runProgram := program.Program{}
 	sync.BranchesProgram(sync.BranchesProgramArgs{
 		BranchProgramArgs: sync.BranchProgramArgs{
@@ -80,16 +103,19 @@ func executeSync(all, dryRun, verbose bool) error {
 			InitialBranch: config.initialBranch,
 			Remotes:       config.remotes,
 			Program:       &runProgram,
-			PushBranch:    true,
+			PushBranch:    pushBranches,
 		},
 		BranchesToSync: config.branchesToSync,
 		DryRun:         dryRun,
 		HasOpenChanges: config.hasOpenChanges,
 		InitialBranch:  config.initialBranch,
 		PreviousBranch: config.previousBranch,
-		ShouldPushTags: config.shouldPushTags,
+		ShouldPushTags: config.shouldPushTags && pushBranches,
 	})
 	runProgram.RemoveDuplicateCheckout()
+	if config.ProposalsShowStack.Bool() && config.connector != nil && config.IsOnline() && !dryRun {
+		runProgram.Add(&opcodes.UpdateProposalStacks{Branches: config.branchesToSync.Names()})
+	}
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: initialBranchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -102,7 +128,7 @@ func executeSync(all, dryRun, verbose bool) error {
 		RunProgram:            runProgram,
 	}
 	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
-		Connector:               nil,
+		Connector:               config.connector,
 		DialogTestInputs:        &config.dialogTestInputs,
 		FullConfig:              config.FullConfig,
 		HasOpenChanges:          config.hasOpenChanges,
@@ -120,6 +146,7 @@ type syncConfig struct {
 	*configdomain.FullConfig
 	allBranches      gitdomain.BranchInfos
 	branchesToSync   gitdomain.BranchInfos
+	connector        hostingdomain.Connector // only set if proposals show the stack
 	dialogTestInputs components.TestInputs
 	hasOpenChanges   bool
 	initialBranch    gitdomain.LocalBranchName
@@ -128,7 +155,7 @@ type syncConfig struct {
 	shouldPushTags   bool
 }
 
-func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult, verbose bool) (*syncConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineSyncConfig(branchNames []string, allFlag, stackFlag bool, repo *execute.OpenRepoResult, verbose bool) (*syncConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -164,25 +191,71 @@ func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult, verbose boo
 		branchNamesToSync = localBranches.Names()
 		shouldPushTags = true
 	} else {
-		err = execute.EnsureKnownBranchAncestry(branchesSnapshot.Active, execute.EnsureKnownBranchAncestryArgs{
-			Config:           &repo.Runner.Config.FullConfig,
-			AllBranches:      branchesSnapshot.Branches,
-			DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
-			DialogTestInputs: &dialogTestInputs,
-			Runner:           repo.Runner,
-		})
-		if err != nil {
-			return nil, branchesSnapshot, stashSize, false, err
+		selectedBranchNames := gitdomain.NewLocalBranchNames(branchNames...)
+		if len(selectedBranchNames) == 0 {
+			err = execute.EnsureKnownBranchAncestry(branchesSnapshot.Active, execute.EnsureKnownBranchAncestryArgs{
+				Config:           &repo.Runner.Config.FullConfig,
+				AllBranches:      branchesSnapshot.Branches,
+				DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+				DialogTestInputs: &dialogTestInputs,
+				Runner:           repo.Runner,
+			})
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, err
+			}
+			selectedBranchNames = gitdomain.LocalBranchNames{branchesSnapshot.Active}
+		} else {
+			var selectedBranches gitdomain.BranchInfos
+			selectedBranches, err = branchesSnapshot.Branches.Select(selectedBranchNames)
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, err
+			}
+			err = execute.EnsureKnownBranchesAncestry(execute.EnsureKnownBranchesAncestryArgs{
+				Config:           &repo.Runner.Config.FullConfig,
+				DialogTestInputs: &dialogTestInputs,
+				LocalBranches:    selectedBranches,
+				Runner:           repo.Runner,
+			})
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, err
+			}
+		}
+		branchNamesToSync = gitdomain.LocalBranchNames{}.AppendAllMissing(selectedBranchNames...)
+		if stackFlag {
+			localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+			for _, selectedBranchName := range selectedBranchNames {
+				for _, descendant := range repo.Runner.Config.FullConfig.Lineage.Descendants(selectedBranchName) {
+					if localBranches.Contains(descendant) {
+						branchNamesToSync = branchNamesToSync.AppendAllMissing(descendant)
+					}
+				}
+			}
+		}
+		for _, selectedBranchName := range selectedBranchNames {
+			if repo.Runner.Config.FullConfig.IsMainOrPerennialBranch(selectedBranchName) {
+				shouldPushTags = true
+			}
 		}
-		branchNamesToSync = gitdomain.LocalBranchNames{branchesSnapshot.Active}
-		shouldPushTags = repo.Runner.Config.FullConfig.IsMainOrPerennialBranch(branchesSnapshot.Active)
 	}
 	allBranchNamesToSync := repo.Runner.Config.FullConfig.Lineage.BranchesAndAncestors(branchNamesToSync)
 	branchesToSync, err := branchesSnapshot.Branches.Select(allBranchNamesToSync)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	var connector hostingdomain.Connector
+	if repo.Runner.Config.FullConfig.ProposalsShowStack.Bool() {
+		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			FullConfig:      &repo.Runner.Config.FullConfig,
+			HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
+			Log:             print.Logger{},
+			OriginURL:       repo.Runner.Config.OriginURL(),
+		})
+	}
 	return &syncConfig{
 		FullConfig:       &repo.Runner.Config.FullConfig,
 		allBranches:      branchesSnapshot.Branches,
 		branchesToSync:   branchesToSync,
+		connector:        connector,
 		dialogTestInputs: dialogTestInputs,
 		hasOpenChanges:   repoStatus.OpenChanges,
 		initialBranch:    branchesSnapshot.Active,
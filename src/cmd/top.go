@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const topDesc = "Switches to the branch at the top of the current stack"
+
+const topHelp = `
+Switches to the youngest descendant of the current branch.
+If the stack forks into several child branches along the way, asks which one to follow.`
+
+func topCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "top",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   topDesc,
+		Long:    cmdhelpers.Long(topDesc, topHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeTop(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeTop(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineNavigateConfig(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	top := config.initialBranch
+	for len(lineage.Children(top)) > 0 {
+		child, aborted, err := navigateToChild(top, repo, config)
+		if err != nil || aborted {
+			return err
+		}
+		top = child
+	}
+	if top == config.initialBranch {
+		fmt.Printf(messages.NavigateAlreadyAtTop, top)
+		return nil
+	}
+	return navigateTo(top, repo, config)
+}
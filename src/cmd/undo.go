@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/cli/flags"
@@ -15,29 +17,44 @@ import (
 	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/git-town/git-town/v12/src/undo"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
 	"github.com/git-town/git-town/v12/src/vm/statefile"
 	"github.com/spf13/cobra"
 )
 
 const undoDesc = "Undoes the most recent Git Town command"
 
+const undoHelp = `
+Git Town remembers the most recent Git Town commands that ran in a repository.
+Running "git town undo" repeatedly goes back further in this history.
+With the --steps option, undoes the given number of most recent commands at once.
+Run "git town history" to see which commands would be undone.
+
+Refuses to undo multiple commands if a branch they changed
+was modified outside of Git Town in between them.`
+
 func undoCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addStepsFlag, readStepsFlag := flags.Int("steps", "n", 1, "Number of most recent commands to undo")
 	cmd := cobra.Command{
 		Use:     "undo",
 		GroupID: "errors",
 		Args:    cobra.NoArgs,
 		Short:   undoDesc,
-		Long:    cmdhelpers.Long(undoDesc),
+		Long:    cmdhelpers.Long(undoDesc, undoHelp),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeUndo(readVerboseFlag(cmd))
+			return executeUndo(readStepsFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addStepsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeUndo(verbose bool) error {
+func executeUndo(steps int, verbose bool) error {
+	if steps < 1 {
+		return errors.New(messages.UndoStepsInvalid)
+	}
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  false,
@@ -59,19 +76,68 @@ func executeUndo(verbose bool) error {
 	if err != nil {
 		return fmt.Errorf(messages.RunstateLoadProblem, err)
 	}
-	if runState == nil {
+	history, err := statefile.LoadHistory(repo.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateLoadProblem, err)
+	}
+	// dry runs didn't change anything and aren't part of the history, undo the command before them
+	if runState != nil && runState.DryRun {
+		err = statefile.Delete(repo.RootDir)
+		if err != nil {
+			return fmt.Errorf(messages.RunstateDeleteProblem, err)
+		}
+		runState = nil
+	}
+	// unfinished commands aren't part of the history
+	if runState != nil && !runState.IsFinished() {
+		if steps > 1 {
+			return errors.New(messages.UndoStepsUnfinished)
+		}
+		return undo.Execute(undo.ExecuteArgs{
+			FullConfig:       config.FullConfig,
+			HasOpenChanges:   config.hasOpenChanges,
+			InitialStashSize: initialStashSize,
+			Lineage:          repo.Runner.Config.FullConfig.Lineage,
+			RootDir:          repo.RootDir,
+			RunState:         *runState,
+			Runner:           repo.Runner,
+			Verbose:          verbose,
+		})
+	}
+	if len(history) == 0 && runState != nil {
+		// the run state was saved by a Git Town version that didn't record the history yet
+		history = history.Add(runstate.HistoryEntry{
+			EndTime:  time.Now(),
+			RunState: *runState,
+		})
+	}
+	if len(history) == 0 {
 		fmt.Println(messages.UndoNothingToDo)
 		return nil
 	}
-	return undo.Execute(undo.ExecuteArgs{
-		FullConfig:       config.FullConfig,
-		HasOpenChanges:   config.hasOpenChanges,
-		InitialStashSize: initialStashSize,
-		Lineage:          repo.Runner.Config.FullConfig.Lineage,
-		RootDir:          repo.RootDir,
-		RunState:         *runState,
-		Runner:           repo.Runner,
-		Verbose:          verbose,
+	if steps > len(history) {
+		return fmt.Errorf(messages.UndoStepsTooMany, steps, len(history))
+	}
+	runStates := make([]runstate.RunState, 0, steps)
+	for _, entry := range history.Last(steps) {
+		runStates = append(runStates, entry.RunState)
+	}
+	inconsistentChanges := undo.InconsistentHistoryChanges(runStates)
+	if len(inconsistentChanges) > 0 {
+		return fmt.Errorf(messages.UndoStepsInconsistent, steps, inconsistentChanges.BranchNames().Join(", "))
+	}
+	return undo.ExecuteHistory(undo.ExecuteHistoryArgs{
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          config.hasOpenChanges,
+		History:                 history,
+		InitialBranchesSnapshot: config.initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		Lineage:                 repo.Runner.Config.FullConfig.Lineage,
+		RootDir:                 repo.RootDir,
+		Runner:                  repo.Runner,
+		Steps:                   steps,
+		Verbose:                 verbose,
 	})
 }
 
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/spf13/cobra"
+)
+
+const upDesc = "Switches to the child branch of the current branch"
+
+const upHelp = `
+If the current branch has several child branches, asks which one to switch to.`
+
+func upCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "up",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   upDesc,
+		Long:    cmdhelpers.Long(upDesc, upHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeUp(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeUp(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineNavigateConfig(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	child, aborted, err := navigateToChild(config.initialBranch, repo, config)
+	if err != nil || aborted {
+		return err
+	}
+	return navigateTo(child, repo, config)
+}
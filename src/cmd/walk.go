@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const walkDesc = "Runs a shell command on multiple branches"
+
+const walkHelp = `
+Checks out each selected branch and runs the given shell command on it.
+With the --stack switch, walks the feature branches in the current stack.
+With the --all switch, walks all local branches.
+Branches are visited in hierarchical order, i.e. parent branches before their children.
+
+If the shell command fails, walk stops on the failing branch.
+You can then run "git town continue" to run the command again,
+"git town skip" to continue with the next branch,
+or "git town undo" to go back to where you started.
+
+Example: git town walk --stack make lint`
+
+func walkCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addAllFlag, readAllFlag := flags.Bool("all", "a", "Run the command on all local branches", flags.FlagTypeNonPersistent)
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Run the command on all branches of the current stack", flags.FlagTypeNonPersistent)
+	cmd := cobra.Command{
+		Use:     "walk [--all | --stack] <command> [<arguments>...]",
+		GroupID: "lineage",
+		Args:    cobra.MinimumNArgs(1),
+		Short:   walkDesc,
+		Long:    cmdhelpers.Long(walkDesc, walkHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeWalk(args, readAllFlag(cmd), readStackFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
+		},
+	}
+	// flags after the shell command belong to the shell command
+	cmd.Flags().SetInterspersed(false)
+	addAllFlag(&cmd)
+	addDryRunFlag(&cmd)
+	addStackFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeWalk(args []string, all, stack, dryRun, verbose bool) error {
+	if all == stack {
+		return errors.New(messages.WalkNoBranchSelection)
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineWalkConfig(repo, args, all, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "walk",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            walkProgram(config),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               nil,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          config.hasOpenChanges,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type walkConfig struct {
+	*configdomain.FullConfig
+	branchesToWalk   gitdomain.LocalBranchNames
+	commandArgs      []string
+	dialogTestInputs components.TestInputs
+	dryRun           bool
+	executable       string
+	hasOpenChanges   bool
+	initialBranch    gitdomain.LocalBranchName
+	previousBranch   gitdomain.LocalBranchName
+}
+
+func determineWalkConfig(repo *execute.OpenRepoResult, args []string, all, dryRun, verbose bool) (*walkConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 false,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	initialBranch := branchesSnapshot.Active
+	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+	var branchesToWalk gitdomain.LocalBranchNames
+	if all {
+		branchesToWalk = localBranches
+	} else {
+		err = execute.EnsureKnownBranchAncestry(initialBranch, execute.EnsureKnownBranchAncestryArgs{
+			Config:           &repo.Runner.Config.FullConfig,
+			AllBranches:      branchesSnapshot.Branches,
+			DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+			DialogTestInputs: &dialogTestInputs,
+			Runner:           repo.Runner,
+		})
+		if err != nil {
+			return nil, branchesSnapshot, stashSize, false, err
+		}
+		lineage := repo.Runner.Config.FullConfig.Lineage
+		stackBranches := append(lineage.BranchAndAncestors(initialBranch), lineage.Descendants(initialBranch)...)
+		for _, branch := range stackBranches {
+			if !repo.Runner.Config.FullConfig.IsMainOrPerennialBranch(branch) && localBranches.Contains(branch) {
+				branchesToWalk = append(branchesToWalk, branch)
+			}
+		}
+	}
+	repo.Runner.Config.FullConfig.Lineage.OrderHierarchically(branchesToWalk)
+	return &walkConfig{
+		FullConfig:       &repo.Runner.Config.FullConfig,
+		branchesToWalk:   branchesToWalk,
+		commandArgs:      args[1:],
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		executable:       args[0],
+		hasOpenChanges:   repoStatus.OpenChanges,
+		initialBranch:    initialBranch,
+		previousBranch:   repo.Runner.Backend.PreviouslyCheckedOutBranch(),
+	}, branchesSnapshot, stashSize, false, nil
+}
+
+func walkProgram(config *walkConfig) program.Program {
+	prog := program.Program{}
+	for _, branch := range config.branchesToWalk {
+		prog.Add(&opcodes.Checkout{Branch: branch})
+		prog.Add(&opcodes.ExecuteShellCommand{
+			Args:       config.commandArgs,
+			Executable: config.executable,
+		})
+		prog.Add(&opcodes.EndOfBranchProgram{})
+	}
+	prog.Add(&opcodes.Checkout{Branch: config.initialBranch})
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   config.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         config.hasOpenChanges,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
+	})
+	return prog
+}
@@ -54,6 +54,12 @@ func (self *Config) AddToPerennialBranches(branches ...gitdomain.LocalBranchName
 	return self.SetPerennialBranches(append(self.FullConfig.PerennialBranches, branches...))
 }
 
+// AddToPrototypeBranches registers the given branch names as prototype branches.
+// The branches must exist.
+func (self *Config) AddToPrototypeBranches(branches ...gitdomain.LocalBranchName) error {
+	return self.SetPrototypeBranches(append(self.FullConfig.PrototypeBranches, branches...))
+}
+
 // OriginURL provides the URL for the "origin" remote.
 // Tests can stub this through the GIT_TOWN_REMOTE environment variable.
 // Caches its result so can be called repeatedly.
@@ -111,6 +117,12 @@ func (self *Config) RemoveFromPerennialBranches(branch gitdomain.LocalBranchName
 	return self.SetPerennialBranches(self.FullConfig.PerennialBranches)
 }
 
+// RemoveFromPrototypeBranches removes the given branch as a prototype branch.
+func (self *Config) RemoveFromPrototypeBranches(branch gitdomain.LocalBranchName) error {
+	self.FullConfig.PrototypeBranches = slice.Remove(self.FullConfig.PrototypeBranches, branch)
+	return self.SetPrototypeBranches(self.FullConfig.PrototypeBranches)
+}
+
 func (self *Config) RemoveMainBranch() {
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyMainBranch)
 }
@@ -219,6 +231,13 @@ func (self *Config) SetPerennialBranches(branches gitdomain.LocalBranchNames) er
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyPerennialBranches, branches.Join(" "))
 }
 
+// SetPrototypeBranches marks the given branches as prototype branches.
+func (self *Config) SetPrototypeBranches(branches gitdomain.LocalBranchNames) error {
+	self.FullConfig.PrototypeBranches = branches
+	self.LocalGitConfig.PrototypeBranches = &branches
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyPrototypeBranches, branches.Join(" "))
+}
+
 // SetPushHookLocally updates the locally configured push-hook strategy.
 func (self *Config) SetPerennialRegexLocally(value configdomain.PerennialRegex) error {
 	self.LocalGitConfig.PerennialRegex = &value
@@ -0,0 +1,13 @@
+package configdomain
+
+// AzureDevOpsToken is a personal access token to use with the Azure DevOps API.
+type AzureDevOpsToken string
+
+func (self AzureDevOpsToken) String() string {
+	return string(self)
+}
+
+func NewAzureDevOpsTokenRef(value string) *AzureDevOpsToken {
+	token := AzureDevOpsToken(value)
+	return &token
+}
@@ -0,0 +1,13 @@
+package configdomain
+
+// BitbucketAppPassword is an app password to authenticate with the Bitbucket API.
+type BitbucketAppPassword string
+
+func (self BitbucketAppPassword) String() string {
+	return string(self)
+}
+
+func NewBitbucketAppPasswordRef(value string) *BitbucketAppPassword {
+	password := BitbucketAppPassword(value)
+	return &password
+}
@@ -0,0 +1,13 @@
+package configdomain
+
+// BitbucketDatacenterToken is a personal access token to use with the API of Bitbucket Data Center.
+type BitbucketDatacenterToken string
+
+func (self BitbucketDatacenterToken) String() string {
+	return string(self)
+}
+
+func NewBitbucketDatacenterTokenRef(value string) *BitbucketDatacenterToken {
+	token := BitbucketDatacenterToken(value)
+	return &token
+}
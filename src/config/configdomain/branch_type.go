@@ -11,14 +11,27 @@ const (
 	BranchTypeParkedBranch
 	BranchTypeContributionBranch
 	BranchTypeObservedBranch
+	BranchTypePrototypeBranch
 )
 
+// IsRewritable indicates whether commands that rewrite the commits of a branch,
+// like compress, swap, detach, and merge, may change branches with this type.
+func (self BranchType) IsRewritable() bool {
+	switch self {
+	case BranchTypeFeatureBranch, BranchTypeParkedBranch, BranchTypePrototypeBranch:
+		return true
+	case BranchTypeContributionBranch, BranchTypeMainBranch, BranchTypeObservedBranch, BranchTypePerennialBranch:
+		return false
+	}
+	panic("unhandled branch type")
+}
+
 // ShouldPush indicates whether a branch with this type should push its local commit to origin.
 func (self BranchType) ShouldPush(currentBranch, initialBranch gitdomain.LocalBranchName) bool {
 	switch self {
 	case BranchTypeMainBranch, BranchTypeFeatureBranch, BranchTypePerennialBranch, BranchTypeContributionBranch:
 		return true
-	case BranchTypeObservedBranch:
+	case BranchTypeObservedBranch, BranchTypePrototypeBranch:
 		return false
 	case BranchTypeParkedBranch:
 		return currentBranch == initialBranch
@@ -40,6 +53,8 @@ func (self BranchType) String() string {
 		return "contribution branch"
 	case BranchTypeObservedBranch:
 		return "observed branch"
+	case BranchTypePrototypeBranch:
+		return "prototype branch"
 	}
 	panic("unhandled branch type")
 }
@@ -0,0 +1,13 @@
+package configdomain
+
+// ForgejoToken is a bearer token to use with the Forgejo API.
+type ForgejoToken string
+
+func (self ForgejoToken) String() string {
+	return string(self)
+}
+
+func NewForgejoTokenRef(value string) *ForgejoToken {
+	token := ForgejoToken(value)
+	return &token
+}
@@ -8,7 +8,12 @@ import (
 // FullConfig is the merged configuration to be used by Git Town commands.
 type FullConfig struct {
 	Aliases                  Aliases
+	AzureDevOpsToken         AzureDevOpsToken
+	BitbucketAppPassword     BitbucketAppPassword
+	BitbucketDatacenterToken BitbucketDatacenterToken
+	BitbucketUsername        BitbucketUsername
 	ContributionBranches     gitdomain.LocalBranchNames
+	ForgejoToken             ForgejoToken
 	GitHubToken              GitHubToken
 	GitLabToken              GitLabToken
 	GitUserEmail             string
@@ -23,12 +28,16 @@ type FullConfig struct {
 	ParkedBranches           gitdomain.LocalBranchNames
 	PerennialBranches        gitdomain.LocalBranchNames
 	PerennialRegex           PerennialRegex
+	ProposalsShowStack       ProposalsShowStack
+	PrototypeBranches        gitdomain.LocalBranchNames
 	PushHook                 PushHook
 	PushNewBranches          PushNewBranches
 	ShipDeleteTrackingBranch ShipDeleteTrackingBranch
+	ShipStrategy             ShipStrategy
 	SyncBeforeShip           SyncBeforeShip
 	SyncFeatureStrategy      SyncFeatureStrategy
 	SyncPerennialStrategy    SyncPerennialStrategy
+	SyncPush                 SyncPush
 	SyncUpstream             SyncUpstream
 }
 
@@ -44,6 +53,8 @@ func (self *FullConfig) BranchType(branch gitdomain.LocalBranchName) BranchType
 		return BranchTypeObservedBranch
 	case self.IsParkedBranch(branch):
 		return BranchTypeParkedBranch
+	case self.IsPrototypeBranch(branch):
+		return BranchTypePrototypeBranch
 	}
 	return BranchTypeFeatureBranch
 }
@@ -88,6 +99,10 @@ func (self *FullConfig) IsPerennialBranch(branch gitdomain.LocalBranchName) bool
 	return self.PerennialRegex.MatchesBranch(branch)
 }
 
+func (self *FullConfig) IsPrototypeBranch(branch gitdomain.LocalBranchName) bool {
+	return slice.Contains(self.PrototypeBranches, branch)
+}
+
 func (self *FullConfig) MainAndPerennials() gitdomain.LocalBranchNames {
 	return append(gitdomain.LocalBranchNames{self.MainBranch}, self.PerennialBranches...)
 }
@@ -102,6 +117,18 @@ func (self *FullConfig) Merge(other PartialConfig) {
 			self.Lineage[child] = parent
 		}
 	}
+	if other.AzureDevOpsToken != nil {
+		self.AzureDevOpsToken = *other.AzureDevOpsToken
+	}
+	if other.BitbucketAppPassword != nil {
+		self.BitbucketAppPassword = *other.BitbucketAppPassword
+	}
+	if other.BitbucketDatacenterToken != nil {
+		self.BitbucketDatacenterToken = *other.BitbucketDatacenterToken
+	}
+	if other.BitbucketUsername != nil {
+		self.BitbucketUsername = *other.BitbucketUsername
+	}
 	if other.ContributionBranches != nil {
 		self.ContributionBranches = append(self.ContributionBranches, *other.ContributionBranches...)
 	}
@@ -111,6 +138,9 @@ func (self *FullConfig) Merge(other PartialConfig) {
 	if other.HostingPlatform != nil {
 		self.HostingPlatform = *other.HostingPlatform
 	}
+	if other.ForgejoToken != nil {
+		self.ForgejoToken = *other.ForgejoToken
+	}
 	if other.GiteaToken != nil {
 		self.GiteaToken = *other.GiteaToken
 	}
@@ -147,12 +177,21 @@ func (self *FullConfig) Merge(other PartialConfig) {
 	if other.PerennialRegex != nil {
 		self.PerennialRegex = *other.PerennialRegex
 	}
+	if other.ProposalsShowStack != nil {
+		self.ProposalsShowStack = *other.ProposalsShowStack
+	}
+	if other.PrototypeBranches != nil {
+		self.PrototypeBranches = append(self.PrototypeBranches, *other.PrototypeBranches...)
+	}
 	if other.PushHook != nil {
 		self.PushHook = *other.PushHook
 	}
 	if other.ShipDeleteTrackingBranch != nil {
 		self.ShipDeleteTrackingBranch = *other.ShipDeleteTrackingBranch
 	}
+	if other.ShipStrategy != nil {
+		self.ShipStrategy = *other.ShipStrategy
+	}
 	if other.SyncBeforeShip != nil {
 		self.SyncBeforeShip = *other.SyncBeforeShip
 	}
@@ -162,6 +201,9 @@ func (self *FullConfig) Merge(other PartialConfig) {
 	if other.SyncPerennialStrategy != nil {
 		self.SyncPerennialStrategy = *other.SyncPerennialStrategy
 	}
+	if other.SyncPush != nil {
+		self.SyncPush = *other.SyncPush
+	}
 	if other.SyncUpstream != nil {
 		self.SyncUpstream = *other.SyncUpstream
 	}
@@ -183,7 +225,12 @@ func (self *FullConfig) ShouldPushNewBranches() bool {
 func DefaultConfig() FullConfig {
 	return FullConfig{
 		Aliases:                  Aliases{},
+		AzureDevOpsToken:         "",
+		BitbucketAppPassword:     "",
+		BitbucketDatacenterToken: "",
+		BitbucketUsername:        "",
 		ContributionBranches:     gitdomain.NewLocalBranchNames(),
+		ForgejoToken:             "",
 		GitHubToken:              "",
 		GitLabToken:              "",
 		GitUserEmail:             "",
@@ -198,12 +245,16 @@ func DefaultConfig() FullConfig {
 		ParkedBranches:           gitdomain.NewLocalBranchNames(),
 		PerennialBranches:        gitdomain.NewLocalBranchNames(),
 		PerennialRegex:           "",
+		ProposalsShowStack:       false,
+		PrototypeBranches:        gitdomain.NewLocalBranchNames(),
 		PushHook:                 true,
 		PushNewBranches:          false,
 		ShipDeleteTrackingBranch: true,
+		ShipStrategy:             ShipStrategySquashMerge,
 		SyncBeforeShip:           false,
 		SyncFeatureStrategy:      SyncFeatureStrategyMerge,
 		SyncPerennialStrategy:    SyncPerennialStrategyRebase,
+		SyncPush:                 true,
 		SyncUpstream:             true,
 	}
 }
@@ -12,6 +12,31 @@ import (
 func TestFullConfig(t *testing.T) {
 	t.Parallel()
 
+	t.Run("BranchType", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			ContributionBranches: gitdomain.NewLocalBranchNames("contribution"),
+			MainBranch:           gitdomain.NewLocalBranchName("main"),
+			ObservedBranches:     gitdomain.NewLocalBranchNames("observed"),
+			ParkedBranches:       gitdomain.NewLocalBranchNames("parked"),
+			PerennialBranches:    gitdomain.NewLocalBranchNames("perennial"),
+			PrototypeBranches:    gitdomain.NewLocalBranchNames("prototype"),
+		}
+		tests := map[string]configdomain.BranchType{
+			"contribution": configdomain.BranchTypeContributionBranch,
+			"feature":      configdomain.BranchTypeFeatureBranch,
+			"main":         configdomain.BranchTypeMainBranch,
+			"observed":     configdomain.BranchTypeObservedBranch,
+			"parked":       configdomain.BranchTypeParkedBranch,
+			"perennial":    configdomain.BranchTypePerennialBranch,
+			"prototype":    configdomain.BranchTypePrototypeBranch,
+		}
+		for give, want := range tests {
+			have := config.BranchType(gitdomain.NewLocalBranchName(give))
+			must.Eq(t, want, have)
+		}
+	})
+
 	t.Run("IsMainOrPerennialBranch", func(t *testing.T) {
 		t.Parallel()
 		config := configdomain.FullConfig{ //nolint:exhaustruct
@@ -20,6 +45,7 @@ func TestFullConfig(t *testing.T) {
 			PerennialBranches:    gitdomain.NewLocalBranchNames("perennial-1", "perennial-2"),
 			ObservedBranches:     gitdomain.NewLocalBranchNames("observed"),
 			ParkedBranches:       gitdomain.NewLocalBranchNames("parked"),
+			PrototypeBranches:    gitdomain.NewLocalBranchNames("prototype"),
 		}
 		tests := map[string]bool{
 			"feature":     false,
@@ -29,6 +55,7 @@ func TestFullConfig(t *testing.T) {
 			"perennial-3": false,
 			"observed":    false,
 			"parked":      false,
+			"prototype":   false,
 		}
 		for give, want := range tests {
 			have := config.IsMainOrPerennialBranch(gitdomain.NewLocalBranchName(give))
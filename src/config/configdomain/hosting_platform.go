@@ -13,11 +13,14 @@ type HostingPlatform string
 func (self HostingPlatform) String() string { return string(self) }
 
 const (
-	HostingPlatformBitbucket = HostingPlatform("bitbucket")
-	HostingPlatformGitHub    = HostingPlatform("github")
-	HostingPlatformGitLab    = HostingPlatform("gitlab")
-	HostingPlatformGitea     = HostingPlatform("gitea")
-	HostingPlatformNone      = HostingPlatform("") // no hosting or auto-detect
+	HostingPlatformAzureDevOps         = HostingPlatform("azure-devops")
+	HostingPlatformBitbucket           = HostingPlatform("bitbucket")
+	HostingPlatformBitbucketDatacenter = HostingPlatform("bitbucket-datacenter")
+	HostingPlatformForgejo             = HostingPlatform("forgejo")
+	HostingPlatformGitHub              = HostingPlatform("github")
+	HostingPlatformGitLab              = HostingPlatform("gitlab")
+	HostingPlatformGitea               = HostingPlatform("gitea")
+	HostingPlatformNone                = HostingPlatform("") // no hosting or auto-detect
 )
 
 // NewHostingPlatform provides the HostingPlatform enum matching the given text.
@@ -41,7 +44,10 @@ func NewHostingPlatformRef(platformName string) (*HostingPlatform, error) {
 func hostingPlatforms() []HostingPlatform {
 	return []HostingPlatform{
 		HostingPlatformNone,
+		HostingPlatformAzureDevOps,
 		HostingPlatformBitbucket,
+		HostingPlatformBitbucketDatacenter,
+		HostingPlatformForgejo,
 		HostingPlatformGitHub,
 		HostingPlatformGitLab,
 		HostingPlatformGitea,
@@ -63,6 +63,17 @@ func (self Lineage) Children(branch gitdomain.LocalBranchName) gitdomain.LocalBr
 	return result
 }
 
+// Descendants provides the names of all branches that have the given branch as an ancestor,
+// ordered hierarchically.
+func (self Lineage) Descendants(branch gitdomain.LocalBranchName) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, child := range self.Children(branch) {
+		result = append(result, child)
+		result = append(result, self.Descendants(child)...)
+	}
+	return result
+}
+
 // HasParents returns whether or not the given branch has at least one parent.
 func (self Lineage) HasParents(branch gitdomain.LocalBranchName) bool {
 	for child := range self {
@@ -157,6 +157,31 @@ func TestLineage(t *testing.T) {
 		})
 	})
 
+	t.Run("Descendants", func(t *testing.T) {
+		t.Parallel()
+		t.Run("provides children and grandchildren, depth first", func(t *testing.T) {
+			t.Parallel()
+			twoA := gitdomain.NewLocalBranchName("twoA")
+			twoB := gitdomain.NewLocalBranchName("twoB")
+			lineage := configdomain.Lineage{}
+			lineage[one] = main
+			lineage[twoA] = one
+			lineage[twoB] = one
+			lineage[three] = twoA
+			have := lineage.Descendants(one)
+			want := gitdomain.LocalBranchNames{twoA, three, twoB}
+			must.Eq(t, want, have)
+		})
+		t.Run("no descendants", func(t *testing.T) {
+			t.Parallel()
+			lineage := configdomain.Lineage{}
+			lineage[one] = main
+			have := lineage.Descendants(one)
+			want := gitdomain.LocalBranchNames{}
+			must.Eq(t, want, have)
+		})
+	})
+
 	t.Run("IsAncestor", func(t *testing.T) {
 		t.Run("recognizes greatgrandparent", func(t *testing.T) {
 			t.Parallel()
@@ -7,7 +7,12 @@ import (
 // PartialConfig contains configuration data as it is stored in the local or global Git configuration.
 type PartialConfig struct {
 	Aliases                  Aliases
+	AzureDevOpsToken         *AzureDevOpsToken
+	BitbucketAppPassword     *BitbucketAppPassword
+	BitbucketDatacenterToken *BitbucketDatacenterToken
+	BitbucketUsername        *BitbucketUsername
 	ContributionBranches     *gitdomain.LocalBranchNames
+	ForgejoToken             *ForgejoToken
 	GitHubToken              *GitHubToken
 	GitLabToken              *GitLabToken
 	GitUserEmail             *string
@@ -22,12 +27,16 @@ type PartialConfig struct {
 	ParkedBranches           *gitdomain.LocalBranchNames
 	PerennialBranches        *gitdomain.LocalBranchNames
 	PerennialRegex           *PerennialRegex
+	ProposalsShowStack       *ProposalsShowStack
+	PrototypeBranches        *gitdomain.LocalBranchNames
 	PushHook                 *PushHook
 	PushNewBranches          *PushNewBranches
 	ShipDeleteTrackingBranch *ShipDeleteTrackingBranch
+	ShipStrategy             *ShipStrategy
 	SyncBeforeShip           *SyncBeforeShip
 	SyncFeatureStrategy      *SyncFeatureStrategy
 	SyncPerennialStrategy    *SyncPerennialStrategy
+	SyncPush                 *SyncPush
 	SyncUpstream             *SyncUpstream
 }
 
@@ -0,0 +1,43 @@
+package configdomain
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/git-town/git-town/v12/src/gohacks"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// ProposalsShowStack contains the configuration setting about whether to show the stack of a proposal in its body.
+type ProposalsShowStack bool
+
+func (self ProposalsShowStack) Bool() bool {
+	return bool(self)
+}
+
+func (self ProposalsShowStack) String() string {
+	return strconv.FormatBool(self.Bool())
+}
+
+func NewProposalsShowStack(value bool) ProposalsShowStack {
+	return ProposalsShowStack(value)
+}
+
+func NewProposalsShowStackRef(value bool) *ProposalsShowStack {
+	result := NewProposalsShowStack(value)
+	return &result
+}
+
+func ParseProposalsShowStack(value, source string) (ProposalsShowStack, error) {
+	parsed, err := gohacks.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf(messages.ValueInvalid, source, value)
+	}
+	result := ProposalsShowStack(parsed)
+	return result, nil
+}
+
+func ParseProposalsShowStackRef(value, source string) (*ProposalsShowStack, error) {
+	result, err := ParseProposalsShowStack(value, source)
+	return &result, err
+}
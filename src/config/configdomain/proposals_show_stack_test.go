@@ -0,0 +1,57 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestProposalsShowStack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Bool", func(t *testing.T) {
+		t.Parallel()
+		give := configdomain.NewProposalsShowStack(true)
+		have := give.Bool()
+		must.True(t, have)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+		give := configdomain.NewProposalsShowStack(true)
+		have := give.String()
+		want := "true"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("NewProposalsShowStack", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.NewProposalsShowStack(true)
+		want := configdomain.ProposalsShowStack(true)
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("NewProposalsShowStackRef", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.NewProposalsShowStackRef(true)
+		want := configdomain.ProposalsShowStack(true)
+		must.EqOp(t, want, *have)
+	})
+
+	t.Run("ParseProposalsShowStack", func(t *testing.T) {
+		t.Parallel()
+		t.Run("parsable value", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseProposalsShowStack("yes", "test")
+			must.NoError(t, err)
+			want := configdomain.NewProposalsShowStack(true)
+			must.EqOp(t, want, have)
+		})
+		t.Run("invalid value", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseProposalsShowStack("zonk", "local config")
+			must.EqOp(t, `invalid value for local config: "zonk". Please provide either "yes" or "no"`, err.Error())
+		})
+	})
+}
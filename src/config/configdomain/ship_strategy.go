@@ -0,0 +1,37 @@
+package configdomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// ShipStrategy defines legal values for the "ship-strategy" configuration setting.
+type ShipStrategy string
+
+func (self ShipStrategy) String() string { return string(self) }
+
+const (
+	ShipStrategyAlwaysMerge = ShipStrategy("always-merge")
+	ShipStrategyFastForward = ShipStrategy("fast-forward")
+	ShipStrategySquashMerge = ShipStrategy("squash-merge")
+)
+
+func NewShipStrategy(text string) (ShipStrategy, error) {
+	switch strings.ToLower(text) {
+	case "always-merge":
+		return ShipStrategyAlwaysMerge, nil
+	case "fast-forward":
+		return ShipStrategyFastForward, nil
+	case "squash-merge", "":
+		return ShipStrategySquashMerge, nil
+	default:
+		return ShipStrategySquashMerge, fmt.Errorf(messages.ConfigShipStrategyUnknown, text)
+	}
+}
+
+func NewShipStrategyRef(text string) (*ShipStrategy, error) {
+	result, err := NewShipStrategy(text)
+	return &result, err
+}
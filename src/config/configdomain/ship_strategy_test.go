@@ -0,0 +1,48 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestNewShipStrategy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid content", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]configdomain.ShipStrategy{
+			"always-merge": configdomain.ShipStrategyAlwaysMerge,
+			"fast-forward": configdomain.ShipStrategyFastForward,
+			"squash-merge": configdomain.ShipStrategySquashMerge,
+		}
+		for give, want := range tests {
+			have, err := configdomain.NewShipStrategy(give)
+			must.NoError(t, err)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("case insensitive", func(t *testing.T) {
+		t.Parallel()
+		for _, give := range []string{"fast-forward", "Fast-Forward", "FAST-FORWARD"} {
+			have, err := configdomain.NewShipStrategy(give)
+			must.NoError(t, err)
+			must.EqOp(t, configdomain.ShipStrategyFastForward, have)
+		}
+	})
+
+	t.Run("defaults to squash-merge", func(t *testing.T) {
+		t.Parallel()
+		have, err := configdomain.NewShipStrategy("")
+		must.NoError(t, err)
+		must.EqOp(t, configdomain.ShipStrategySquashMerge, have)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+		_, err := configdomain.NewShipStrategy("zonk")
+		must.Error(t, err)
+	})
+}
@@ -0,0 +1,43 @@
+package configdomain
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/git-town/git-town/v12/src/gohacks"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// SyncPush contains the configuration setting whether "git town sync" pushes the synced branches.
+type SyncPush bool
+
+func (self SyncPush) Bool() bool {
+	return bool(self)
+}
+
+func (self SyncPush) String() string {
+	return strconv.FormatBool(self.Bool())
+}
+
+func NewSyncPush(value bool) SyncPush {
+	return SyncPush(value)
+}
+
+func NewSyncPushRef(value bool) *SyncPush {
+	result := NewSyncPush(value)
+	return &result
+}
+
+func ParseSyncPush(value, source string) (SyncPush, error) {
+	parsed, err := gohacks.ParseBool(value)
+	if err != nil {
+		return true, fmt.Errorf(messages.ValueInvalid, source, value)
+	}
+	result := SyncPush(parsed)
+	return result, nil
+}
+
+func ParseSyncPushRef(value, source string) (*SyncPush, error) {
+	result, err := ParseSyncPush(value, source)
+	return &result, err
+}
@@ -0,0 +1,57 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestSyncPush(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Bool", func(t *testing.T) {
+		t.Parallel()
+		give := configdomain.NewSyncPush(true)
+		have := give.Bool()
+		must.True(t, have)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+		give := configdomain.NewSyncPush(true)
+		have := give.String()
+		want := "true"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("NewSyncPush", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.NewSyncPush(true)
+		want := configdomain.SyncPush(true)
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("NewSyncPushRef", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.NewSyncPushRef(true)
+		want := configdomain.SyncPush(true)
+		must.EqOp(t, want, *have)
+	})
+
+	t.Run("ParseSyncPush", func(t *testing.T) {
+		t.Parallel()
+		t.Run("parsable value", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseSyncPush("yes", "test")
+			must.NoError(t, err)
+			want := configdomain.NewSyncPush(true)
+			must.EqOp(t, want, have)
+		})
+		t.Run("invalid value", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseSyncPush("zonk", "local config")
+			must.EqOp(t, `invalid value for local config: "zonk". Please provide either "yes" or "no"`, err.Error())
+		})
+	})
+}
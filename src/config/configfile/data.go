@@ -4,10 +4,13 @@ package configfile
 type Data struct {
 	Branches                 *Branches     `toml:"branches"`
 	Hosting                  *Hosting      `toml:"hosting"`
+	ProposalsShowStack       *bool         `toml:"proposals-show-stack"`
 	PushHook                 *bool         `toml:"push-hook"`
 	PushNewbranches          *bool         `toml:"push-new-branches"`
 	ShipDeleteTrackingBranch *bool         `toml:"ship-delete-tracking-branch"`
+	ShipStrategy             *string       `toml:"ship-strategy"`
 	SyncBeforeShip           *bool         `toml:"sync-before-ship"`
+	SyncPush                 *bool         `toml:"sync-push"`
 	SyncStrategy             *SyncStrategy `toml:"sync-strategy"`
 	SyncUpstream             *bool         `toml:"sync-upstream"`
 }
@@ -70,12 +70,21 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 	if data.PushNewbranches != nil {
 		result.PushNewBranches = configdomain.NewPushNewBranchesRef(*data.PushNewbranches)
 	}
+	if data.ProposalsShowStack != nil {
+		result.ProposalsShowStack = configdomain.NewProposalsShowStackRef(*data.ProposalsShowStack)
+	}
 	if data.ShipDeleteTrackingBranch != nil {
 		result.ShipDeleteTrackingBranch = configdomain.NewShipDeleteTrackingBranchRef(*data.ShipDeleteTrackingBranch)
 	}
+	if data.ShipStrategy != nil {
+		result.ShipStrategy, err = configdomain.NewShipStrategyRef(*data.ShipStrategy)
+	}
 	if data.SyncBeforeShip != nil {
 		result.SyncBeforeShip = configdomain.NewSyncBeforeShipRef(*data.SyncBeforeShip)
 	}
+	if data.SyncPush != nil {
+		result.SyncPush = configdomain.NewSyncPushRef(*data.SyncPush)
+	}
 	if data.SyncUpstream != nil {
 		result.SyncUpstream = configdomain.NewSyncUpstreamRef(*data.SyncUpstream)
 	}
@@ -15,10 +15,13 @@ func TestConfigfile(t *testing.T) {
 		t.Run("complete content", func(t *testing.T) {
 			t.Parallel()
 			give := `
+proposals-show-stack = true
 push-hook = true
 push-new-branches = true
 ship-delete-tracking-branch = false
+ship-strategy = "fast-forward"
 sync-before-ship = false
+sync-push = false
 sync-upstream = true
 
 [branches]
@@ -40,12 +43,15 @@ perennial-branches = "rebase"
 			githubCom := "github.com"
 			main := "main"
 			merge := "merge"
+			proposalsShowStack := true
 			pushNewBranches := true
 			pushHook := true
 			rebase := "rebase"
 			releaseRegex := "release-.*"
 			shipDeleteTrackingBranch := false
+			shipStrategy := "fast-forward"
 			syncBeforeShip := false
+			syncPush := false
 			syncUpstream := true
 			want := configfile.Data{
 				Branches: &configfile.Branches{
@@ -61,10 +67,13 @@ perennial-branches = "rebase"
 					FeatureBranches:   &merge,
 					PerennialBranches: &rebase,
 				},
+				ProposalsShowStack:       &proposalsShowStack,
 				PushHook:                 &pushHook,
 				PushNewbranches:          &pushNewBranches,
 				ShipDeleteTrackingBranch: &shipDeleteTrackingBranch,
+				ShipStrategy:             &shipStrategy,
 				SyncBeforeShip:           &syncBeforeShip,
+				SyncPush:                 &syncPush,
 				SyncUpstream:             &syncUpstream,
 			}
 			must.Eq(t, want, *have)
@@ -88,9 +97,12 @@ main = "main"
 				Hosting:                  nil,
 				SyncStrategy:             nil,
 				PushNewbranches:          nil,
+				ProposalsShowStack:       nil,
 				PushHook:                 nil,
 				ShipDeleteTrackingBranch: nil,
+				ShipStrategy:             nil,
 				SyncBeforeShip:           nil,
+				SyncPush:                 nil,
 				SyncUpstream:             nil,
 			}
 			must.Eq(t, want, *have)
@@ -0,0 +1,7 @@
+package envconfig
+
+import "os"
+
+func GitHubAPIURLOverride() string {
+	return os.Getenv("GIT_TOWN_GITHUB_API_URL")
+}
@@ -71,12 +71,22 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 		config.Aliases[configdomain.AliasableCommandShip] = value
 	case KeyAliasSync:
 		config.Aliases[configdomain.AliasableCommandSync] = value
+	case KeyAzureDevOpsToken:
+		config.AzureDevOpsToken = configdomain.NewAzureDevOpsTokenRef(value)
+	case KeyBitbucketAppPassword:
+		config.BitbucketAppPassword = configdomain.NewBitbucketAppPasswordRef(value)
+	case KeyBitbucketDatacenterToken:
+		config.BitbucketDatacenterToken = configdomain.NewBitbucketDatacenterTokenRef(value)
+	case KeyBitbucketUsername:
+		config.BitbucketUsername = configdomain.NewBitbucketUsernameRef(value)
 	case KeyContributionBranches:
 		config.ContributionBranches = gitdomain.ParseLocalBranchNamesRef(value)
 	case KeyHostingOriginHostname:
 		config.HostingOriginHostname = configdomain.NewHostingOriginHostnameRef(value)
 	case KeyHostingPlatform:
 		config.HostingPlatform, err = configdomain.NewHostingPlatformRef(value)
+	case KeyForgejoToken:
+		config.ForgejoToken = configdomain.NewForgejoTokenRef(value)
 	case KeyGiteaToken:
 		config.GiteaToken = configdomain.NewGiteaTokenRef(value)
 	case KeyGithubToken:
@@ -99,18 +109,26 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 		config.PerennialBranches = gitdomain.ParseLocalBranchNamesRef(value)
 	case KeyPerennialRegex:
 		config.PerennialRegex = configdomain.NewPerennialRegexRef(value)
+	case KeyProposalsShowStack:
+		config.ProposalsShowStack, err = configdomain.ParseProposalsShowStackRef(value, KeyProposalsShowStack.String())
+	case KeyPrototypeBranches:
+		config.PrototypeBranches = gitdomain.ParseLocalBranchNamesRef(value)
 	case KeyPushHook:
 		config.PushHook, err = configdomain.NewPushHookRef(value, KeyPushHook.String())
 	case KeyPushNewBranches:
 		config.PushNewBranches, err = configdomain.ParsePushNewBranchesRef(value, KeyPushNewBranches.String())
 	case KeyShipDeleteTrackingBranch:
 		config.ShipDeleteTrackingBranch, err = configdomain.ParseShipDeleteTrackingBranchRef(value, KeyShipDeleteTrackingBranch.String())
+	case KeyShipStrategy:
+		config.ShipStrategy, err = configdomain.NewShipStrategyRef(value)
 	case KeySyncBeforeShip:
 		config.SyncBeforeShip, err = configdomain.ParseSyncBeforeShipRef(value, KeySyncBeforeShip.String())
 	case KeySyncFeatureStrategy:
 		config.SyncFeatureStrategy, err = configdomain.NewSyncFeatureStrategyRef(value)
 	case KeySyncPerennialStrategy:
 		config.SyncPerennialStrategy, err = configdomain.NewSyncPerennialStrategyRef(value)
+	case KeySyncPush:
+		config.SyncPush, err = configdomain.ParseSyncPushRef(value, KeySyncPush.String())
 	case KeySyncUpstream:
 		config.SyncUpstream, err = configdomain.ParseSyncUpstreamRef(value, KeySyncUpstream.String())
 	case KeyDeprecatedCodeHostingDriver,
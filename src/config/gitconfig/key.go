@@ -42,6 +42,10 @@ const (
 	KeyAliasSetParent                      = Key("alias.set-parent")
 	KeyAliasShip                           = Key("alias.ship")
 	KeyAliasSync                           = Key("alias.sync")
+	KeyAzureDevOpsToken                    = Key("git-town.azure-devops-token")
+	KeyBitbucketAppPassword                = Key("git-town.bitbucket-app-password")
+	KeyBitbucketDatacenterToken            = Key("git-town.bitbucket-datacenter-token")
+	KeyBitbucketUsername                   = Key("git-town.bitbucket-username")
 	KeyContributionBranches                = Key("git-town.contribution-branches")
 	KeyDeprecatedCodeHostingDriver         = Key("git-town.code-hosting-driver")
 	KeyDeprecatedCodeHostingOriginHostname = Key("git-town.code-hosting-origin-hostname")
@@ -53,6 +57,7 @@ const (
 	KeyDeprecatedPushVerify                = Key("git-town.push-verify")
 	KeyDeprecatedShipDeleteRemoteBranch    = Key("git-town.ship-delete-remote-branch")
 	KeyDeprecatedSyncStrategy              = Key("git-town.sync-strategy")
+	KeyForgejoToken                        = Key("git-town.forgejo-token")
 	KeyGiteaToken                          = Key("git-town.gitea-token")
 	KeyGithubToken                         = Key("git-town.github-token")
 	KeyGitlabToken                         = Key("git-town.gitlab-token")
@@ -64,12 +69,16 @@ const (
 	KeyParkedBranches                      = Key("git-town.parked-branches")
 	KeyPerennialBranches                   = Key("git-town.perennial-branches")
 	KeyPerennialRegex                      = Key("git-town.perennial-regex")
+	KeyProposalsShowStack                  = Key("git-town.proposals-show-stack")
+	KeyPrototypeBranches                   = Key("git-town.prototype-branches")
 	KeyPushHook                            = Key("git-town.push-hook")
 	KeyPushNewBranches                     = Key("git-town.push-new-branches")
 	KeyShipDeleteTrackingBranch            = Key("git-town.ship-delete-tracking-branch")
+	KeyShipStrategy                        = Key("git-town.ship-strategy")
 	KeySyncBeforeShip                      = Key("git-town.sync-before-ship")
 	KeySyncFeatureStrategy                 = Key("git-town.sync-feature-strategy")
 	KeySyncPerennialStrategy               = Key("git-town.sync-perennial-strategy")
+	KeySyncPush                            = Key("git-town.sync-push")
 	KeySyncStrategy                        = Key("git-town.sync-strategy")
 	KeySyncUpstream                        = Key("git-town.sync-upstream")
 	KeyGitUserEmail                        = Key("user.email")
@@ -79,6 +88,10 @@ const (
 var keys = []Key{ //nolint:gochecknoglobals
 	KeyHostingOriginHostname,
 	KeyHostingPlatform,
+	KeyAzureDevOpsToken,
+	KeyBitbucketAppPassword,
+	KeyBitbucketDatacenterToken,
+	KeyBitbucketUsername,
 	KeyContributionBranches,
 	KeyDeprecatedCodeHostingDriver,
 	KeyDeprecatedCodeHostingOriginHostname,
@@ -90,6 +103,7 @@ var keys = []Key{ //nolint:gochecknoglobals
 	KeyDeprecatedPushVerify,
 	KeyDeprecatedShipDeleteRemoteBranch,
 	KeyDeprecatedSyncStrategy,
+	KeyForgejoToken,
 	KeyGiteaToken,
 	KeyGithubToken,
 	KeyGitlabToken,
@@ -101,12 +115,16 @@ var keys = []Key{ //nolint:gochecknoglobals
 	KeyParkedBranches,
 	KeyPerennialBranches,
 	KeyPerennialRegex,
+	KeyProposalsShowStack,
+	KeyPrototypeBranches,
 	KeyPushHook,
 	KeyPushNewBranches,
 	KeyShipDeleteTrackingBranch,
+	KeyShipStrategy,
 	KeySyncBeforeShip,
 	KeySyncFeatureStrategy,
 	KeySyncPerennialStrategy,
+	KeySyncPush,
 	KeySyncStrategy,
 	KeySyncUpstream,
 }
@@ -34,6 +34,27 @@ type BackendCommands struct {
 	Runner             BackendRunner  // executes shell commands in the directory of the Git repo
 }
 
+// AheadBehindCounts provides how many commits the given local branch is ahead and behind the given tracking branch.
+func (self *BackendCommands) AheadBehindCounts(branch gitdomain.LocalBranchName, trackingBranch gitdomain.RemoteBranchName) (ahead, behind int, err error) { //nolint:nonamedreturns
+	output, err := self.Runner.QueryTrim("git", "rev-list", "--left-right", "--count", branch.String()+"..."+trackingBranch.String())
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.AheadBehindCountsProblem, branch, err)
+	}
+	parts := strings.Fields(output)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf(messages.AheadBehindCountsUnexpectedOutput, branch, output)
+	}
+	ahead, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.AheadBehindCountsUnexpectedOutput, branch, output)
+	}
+	behind, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.AheadBehindCountsUnexpectedOutput, branch, output)
+	}
+	return ahead, behind, nil
+}
+
 // Author provides the locally Git configured user.
 func (self *BackendCommands) Author() (string, error) {
 	email := self.Config.FullConfig.GitUserEmail
@@ -68,6 +89,12 @@ func (self *BackendCommands) BranchExists(branch gitdomain.LocalBranchName) bool
 	return err == nil
 }
 
+// BranchInSyncWithParent indicates whether the given branch contains all commits of the given parent branch.
+func (self *BackendCommands) BranchInSyncWithParent(branch, parent gitdomain.LocalBranchName) bool {
+	err := self.Runner.Run("git", "merge-base", "--is-ancestor", parent.String(), branch.String())
+	return err == nil
+}
+
 // BranchHasUnmergedChanges indicates whether the branch with the given name
 // contains changes that were not merged into the main branch.
 func (self *BackendCommands) BranchHasUnmergedChanges(branch, parent gitdomain.LocalBranchName) (bool, error) {
@@ -78,6 +105,41 @@ func (self *BackendCommands) BranchHasUnmergedChanges(branch, parent gitdomain.L
 	return out != "", nil
 }
 
+// BranchChangesMergedInto indicates whether the changes made in the given branch are already contained in the given target branch.
+// This is the case when the branch was merged normally or squash-merged into the target branch
+// and the target branch hasn't changed the files that the branch changes since then.
+// This only compares the content of the branches and doesn't write to the repository.
+func (self *BackendCommands) BranchChangesMergedInto(branch gitdomain.LocalBranchName, target gitdomain.BranchName) (bool, error) {
+	err := self.Runner.Run("git", "merge-base", "--is-ancestor", branch.String(), target.String())
+	if err == nil {
+		return true, nil
+	}
+	mergeBase, err := self.Runner.QueryTrim("git", "merge-base", target.String(), branch.String())
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchMergedProblem, branch, err)
+	}
+	output, err := self.Runner.QueryTrim("git", "diff", "--name-only", "--no-renames", "-z", mergeBase, branch.String())
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchMergedProblem, branch, err)
+	}
+	changedFiles := []string{}
+	for _, file := range strings.Split(output, "\x00") {
+		if file != "" {
+			changedFiles = append(changedFiles, file)
+		}
+	}
+	if len(changedFiles) == 0 {
+		return true, nil
+	}
+	// the changes of the branch are in the target branch if the files that the branch changes have the same content in both branches
+	args := append([]string{"--literal-pathspecs", "diff", "--name-only", branch.String(), target.String(), "--"}, changedFiles...)
+	differences, err := self.Runner.QueryTrim("git", args...)
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchMergedProblem, branch, err)
+	}
+	return differences == "", nil
+}
+
 // BranchesSnapshot provides detailed information about the sync status of all branches.
 func (self *BackendCommands) BranchesSnapshot() (gitdomain.BranchesSnapshot, error) { //nolint:nonamedreturns
 	output, err := self.Runner.Query("git", "branch", "-vva")
@@ -208,6 +270,15 @@ func (self *BackendCommands) CommitsInFeatureBranch(branch, parent gitdomain.Loc
 	return result, nil
 }
 
+// CommitMessage provides the full commit message of the commit with the given SHA.
+func (self *BackendCommands) CommitMessage(sha gitdomain.SHA) (string, error) {
+	out, err := self.Runner.QueryTrim("git", "log", "-1", "--format=%B", sha.String())
+	if err != nil {
+		return "", fmt.Errorf(messages.CommitMessageProblem, err)
+	}
+	return out, nil
+}
+
 func (self *BackendCommands) CommitsInPerennialBranch() (gitdomain.SHAs, error) {
 	output, err := self.Runner.QueryTrim("git", "log", "--pretty=format:%h", "-10")
 	if err != nil {
@@ -293,6 +364,16 @@ func (self *BackendCommands) HasShippableChanges(branch, mainBranch gitdomain.Lo
 	return out != "", nil
 }
 
+// IsMergeCommit indicates whether the commit with the given SHA has more than one parent.
+func (self *BackendCommands) IsMergeCommit(sha gitdomain.SHA) (bool, error) {
+	out, err := self.Runner.QueryTrim("git", "rev-list", "--parents", "-n", "1", sha.String())
+	if err != nil {
+		return false, err
+	}
+	// the output contains the given commit followed by its parents
+	return len(strings.Fields(out)) > 2, nil
+}
+
 // LastCommitMessage provides the commit message for the last commit.
 func (self *BackendCommands) LastCommitMessage() (string, error) {
 	out, err := self.Runner.QueryTrim("git", "log", "-1", "--format=%B")
@@ -39,6 +39,104 @@ func TestBackendCommands(t *testing.T) {
 		must.Eq(t, []string{"user <email@example.com>"}, authors)
 	})
 
+	t.Run("BranchChangesMergedInto", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch was merged", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "file1",
+				Message:     "branch commit",
+			})
+			runtime.CheckoutBranch(initial)
+			must.NoError(t, runtime.MergeBranch(branch))
+			have, err := runtime.Backend.BranchChangesMergedInto(branch, initial.BranchName())
+			must.NoError(t, err)
+			must.True(t, have)
+		})
+		t.Run("branch was squash-merged", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "file1",
+				Message:     "branch commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "branch content",
+				FileName:    "file1",
+				Message:     "squashed branch commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "other content",
+				FileName:    "file2",
+				Message:     "other commit",
+			})
+			objectsBefore := runtime.MustQuery("git", "count-objects")
+			have, err := runtime.Backend.BranchChangesMergedInto(branch, initial.BranchName())
+			must.NoError(t, err)
+			must.True(t, have)
+			must.EqOp(t, objectsBefore, runtime.MustQuery("git", "count-objects"), must.Sprint("doesn't write objects"))
+		})
+		t.Run("branch was not merged", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "file1",
+				Message:     "branch commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "other content",
+				FileName:    "file2",
+				Message:     "other commit",
+			})
+			have, err := runtime.Backend.BranchChangesMergedInto(branch, initial.BranchName())
+			must.NoError(t, err)
+			must.False(t, have)
+		})
+		t.Run("target branch changed the merged files afterwards", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "file1",
+				Message:     "branch commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "branch content",
+				FileName:    "file1",
+				Message:     "squashed branch commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "newer content",
+				FileName:    "file1",
+				Message:     "newer commit",
+			})
+			have, err := runtime.Backend.BranchChangesMergedInto(branch, initial.BranchName())
+			must.NoError(t, err)
+			must.False(t, have)
+		})
+	})
+
 	t.Run("BranchHasUnmergedChanges", func(t *testing.T) {
 		t.Parallel()
 		t.Run("branch without commits", func(t *testing.T) {
@@ -139,6 +139,16 @@ func (self *FrontendCommands) DiscardOpenChanges() error {
 	return self.Runner.Run("git", "reset", "--hard")
 }
 
+// ExecuteShellCommand runs the given shell command in the current directory.
+func (self *FrontendCommands) ExecuteShellCommand(executable string, args []string) error {
+	return self.Runner.Run(executable, args...)
+}
+
+// FastForwardMerge fast-forwards the current branch to the given branch.
+func (self *FrontendCommands) FastForwardMerge(branch gitdomain.LocalBranchName) error {
+	return self.Runner.Run("git", "merge", "--ff-only", branch.String())
+}
+
 // Fetch retrieves the updates from the origin repo.
 func (self *FrontendCommands) Fetch() error {
 	return self.Runner.Run("git", "fetch", "--prune", "--tags")
@@ -164,6 +174,20 @@ func (self *FrontendCommands) MergeBranchNoEdit(branch gitdomain.BranchName) err
 	return self.Runner.Run("git", "merge", "--no-edit", branch.String())
 }
 
+// MergeNoFastForward merges the given branch into the current branch,
+// always creating a merge commit.
+// Uses the default commit message if the given message is empty.
+func (self *FrontendCommands) MergeNoFastForward(branch gitdomain.LocalBranchName, message string) error {
+	gitArgs := []string{"merge", "--no-ff"}
+	if message != "" {
+		gitArgs = append(gitArgs, "-m", message)
+	} else {
+		gitArgs = append(gitArgs, "--no-edit")
+	}
+	gitArgs = append(gitArgs, branch.String())
+	return self.Runner.Run("git", gitArgs...)
+}
+
 // NavigateToDir changes into the root directory of the current repository.
 func (self *FrontendCommands) NavigateToDir(dir gitdomain.RepoRootDir) error {
 	return os.Chdir(dir.String())
@@ -198,6 +222,11 @@ func (self *FrontendCommands) Rebase(target gitdomain.BranchName) error {
 	return self.Runner.Run("git", "rebase", target.String())
 }
 
+// RebaseOnto moves the commits of the current branch that aren't in commitsToRemove onto the given branch.
+func (self *FrontendCommands) RebaseOnto(branchToRebaseAgainst gitdomain.BranchName, commitsToRemove gitdomain.Location) error {
+	return self.Runner.Run("git", "rebase", "--onto", branchToRebaseAgainst.String(), commitsToRemove.String())
+}
+
 // RemoveGitAlias removes the given Git alias.
 func (self *FrontendCommands) RemoveGitAlias(aliasableCommand configdomain.AliasableCommand) error {
 	aliasKey := gitconfig.KeyForAliasableCommand(aliasableCommand)
@@ -214,6 +243,12 @@ func (self *FrontendCommands) ResetCurrentBranchToSHA(sha gitdomain.SHA, hard bo
 	return self.Runner.Run("git", args...)
 }
 
+// ResetCurrentBranchToParent removes all commits of the current branch that aren't in the given parent branch,
+// keeping their changes staged.
+func (self *FrontendCommands) ResetCurrentBranchToParent(parent gitdomain.LocalBranchName) error {
+	return self.Runner.Run("git", "reset", "--soft", parent.String())
+}
+
 // ResetRemoteBranchToSHA sets the given remote branch to the given SHA.
 func (self *FrontendCommands) ResetRemoteBranchToSHA(branch gitdomain.RemoteBranchName, sha gitdomain.SHA) error {
 	return self.Runner.Run("git", "push", "--force-with-lease", gitdomain.OriginRemote.String(), sha.String()+":"+branch.LocalBranchName().String())
@@ -224,6 +259,31 @@ func (self *FrontendCommands) RevertCommit(sha gitdomain.SHA) error {
 	return self.Runner.Run("git", "revert", sha.String())
 }
 
+// RevertMergeCommit reverts the merge commit with the given SHA, keeping the first parent as the mainline.
+func (self *FrontendCommands) RevertMergeCommit(sha gitdomain.SHA) error {
+	return self.Runner.Run("git", "revert", "-m", "1", sha.String())
+}
+
+// SetAzureDevOpsToken sets the given API token for the Azure DevOps API.
+func (self *FrontendCommands) SetAzureDevOpsToken(value configdomain.AzureDevOpsToken) error {
+	return self.Runner.Run("git", "config", gitconfig.KeyAzureDevOpsToken.String(), value.String())
+}
+
+// SetBitbucketAppPassword sets the given app password for the Bitbucket API.
+func (self *FrontendCommands) SetBitbucketAppPassword(value configdomain.BitbucketAppPassword) error {
+	return self.Runner.Run("git", "config", gitconfig.KeyBitbucketAppPassword.String(), value.String())
+}
+
+// SetBitbucketDatacenterToken sets the given API token for the Bitbucket Data Center API.
+func (self *FrontendCommands) SetBitbucketDatacenterToken(value configdomain.BitbucketDatacenterToken) error {
+	return self.Runner.Run("git", "config", gitconfig.KeyBitbucketDatacenterToken.String(), value.String())
+}
+
+// SetBitbucketUsername sets the given username for the Bitbucket API.
+func (self *FrontendCommands) SetBitbucketUsername(value configdomain.BitbucketUsername) error {
+	return self.Runner.Run("git", "config", gitconfig.KeyBitbucketUsername.String(), value.String())
+}
+
 // SetGitAlias sets the given Git alias.
 func (self *FrontendCommands) SetGitAlias(aliasableCommand configdomain.AliasableCommand) error {
 	return self.Runner.Run("git", "config", "--global", gitconfig.KeyForAliasableCommand(aliasableCommand).String(), "town "+aliasableCommand.String())
@@ -239,6 +299,11 @@ func (self *FrontendCommands) SetGitLabToken(value configdomain.GitLabToken) err
 	return self.Runner.Run("git", "config", "git-town.gitlab-token", value.String())
 }
 
+// SetForgejoToken sets the given API token for the Forgejo API.
+func (self *FrontendCommands) SetForgejoToken(value configdomain.ForgejoToken) error {
+	return self.Runner.Run("git", "config", gitconfig.KeyForgejoToken.String(), value.String())
+}
+
 // SetGiteaToken sets the given API token for the Gitea API.
 func (self *FrontendCommands) SetGiteaToken(value configdomain.GiteaToken) error {
 	return self.Runner.Run("git", "config", "git-town.gitea-token", value.String())
@@ -15,6 +15,10 @@ type Parts struct {
 
 func Parse(url string) *Parts {
 	patterns := []string{
+		// Azure DevOps: https://dev.azure.com/<org>/<project>/_git/<repo>
+		`^[^:]+://(?P<user>[^@/]*@)?(?P<host>[^/]+\/)(?P<org>.+\/)_git\/(?P<repo>[^/]+?)(?:\.git)?$`,
+		// Azure DevOps: git@ssh.dev.azure.com:v3/<org>/<project>/<repo>
+		`^(?:ssh://)?(?P<user>[^@/]*@)?(?P<host>ssh\.dev\.azure\.com[:/])v3\/(?P<org>[^/]+\/[^/]+\/)(?P<repo>[^/]+?)(?:\.git)?$`,
 		`^[^:]+://(?P<user>.*@)?(?P<host>.*\/)(?P<org>.*\/)(?P<repo>.*)\.git$`,
 		`^[^:]+://(?P<user>.*@)?(?P<host>.*\/)(?P<org>.*\/)(?P<repo>.*)$`,
 		`^(?P<user>.*@)?(?P<host>.*?[:/])(?P<org>.*\/)(?P<repo>.*)\.git$`,
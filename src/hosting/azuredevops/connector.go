@@ -0,0 +1,263 @@
+package azuredevops
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// apiVersion is the version of the Azure DevOps REST API that this connector talks to.
+const apiVersion = "7.0"
+
+// refPrefix is the prefix of the fully qualified Git refs of branches.
+const refPrefix = "refs/heads/"
+
+// Connector provides access to the API of Azure DevOps.
+type Connector struct {
+	hostingdomain.Config
+	APIToken configdomain.AzureDevOpsToken
+	BaseURL  string // URL of the server, tests point this to a stand-in server
+	client   *http.Client
+	log      print.Logger
+}
+
+// NewConnector provides an Azure DevOps connector instance if the current repo is hosted on Azure DevOps,
+// otherwise nil.
+func NewConnector(args NewConnectorArgs) (*Connector, error) {
+	// SSH remotes of Azure DevOps use the dedicated host ssh.dev.azure.com, the web UI and API live on dev.azure.com
+	hostname := strings.TrimPrefix(args.OriginURL.Host, "ssh.")
+	connector := Connector{
+		APIToken: args.APIToken,
+		BaseURL:  "",
+		Config: hostingdomain.Config{
+			Hostname:     hostname,
+			Organization: args.OriginURL.Org,
+			Repository:   args.OriginURL.Repo,
+		},
+		client: &http.Client{},
+		log:    args.Log,
+	}
+	connector.BaseURL = "https://" + connector.HostnameWithStandardPort()
+	return &connector, nil
+}
+
+type NewConnectorArgs struct {
+	APIToken        configdomain.AzureDevOpsToken
+	HostingPlatform configdomain.HostingPlatform
+	Log             print.Logger
+	OriginURL       *giturl.Parts
+}
+
+func (self *Connector) CloseProposal(number int) error {
+	self.log.Start(messages.HostingAzureDevOpsClosePRViaAPI, number)
+	payload := map[string]any{
+		"status": "abandoned",
+	}
+	err := self.request(http.MethodPatch, self.apiURL(fmt.Sprintf("/%d", number), nil), payload, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingAzureDevOpsCreatingPRViaAPI, branch)
+	payload := map[string]any{
+		"description":   body,
+		"isDraft":       draft,
+		"sourceRefName": refName(branch),
+		"targetRefName": refName(target),
+		"title":         title,
+	}
+	var created pullRequest
+	err := self.request(http.MethodPost, self.apiURL("", nil), payload, &created)
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //nolint:exhaustruct
+	}
+	self.log.Success()
+	return created.proposal(self.RepositoryURL()), nil
+}
+
+func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	return fmt.Sprintf("Merged PR %d: %s", proposal.Number, proposal.Title)
+}
+
+func (self *Connector) FastForwardProposal(number int) error {
+	return self.completeProposal(number, "", "rebase")
+}
+
+func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	params := url.Values{}
+	params.Set("searchCriteria.sourceRefName", refName(branch))
+	params.Set("searchCriteria.status", "active")
+	if !target.IsEmpty() {
+		params.Set("searchCriteria.targetRefName", refName(target))
+	}
+	var response pullRequestList
+	err := self.request(http.MethodGet, self.apiURL("", params), nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Value) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	if len(response.Value) > 1 {
+		return nil, fmt.Errorf(messages.ProposalMultipleFound, len(response.Value), branch, target)
+	}
+	proposal := response.Value[0].proposal(self.RepositoryURL())
+	return &proposal, nil
+}
+
+func (self *Connector) MergeCommitProposal(number int, message string) error {
+	return self.completeProposal(number, message, "noFastForward")
+}
+
+func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
+	return fmt.Sprintf("%s/pullrequestcreate?sourceRef=%s&targetRef=%s",
+			self.RepositoryURL(),
+			url.QueryEscape(branch.String()),
+			url.QueryEscape(parentBranch.String())),
+		nil
+}
+
+func (self *Connector) RepositoryURL() string {
+	return fmt.Sprintf("https://%s/%s/_git/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
+}
+
+func (self *Connector) SquashMergeProposal(number int, message string) error {
+	return self.completeProposal(number, message, "squash")
+}
+
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingAzureDevOpsUpdatePRBodyViaAPI, number)
+	payload := map[string]any{
+		"description": body,
+	}
+	err := self.request(http.MethodPatch, self.apiURL(fmt.Sprintf("/%d", number), nil), payload, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingAzureDevOpsUpdatePRViaAPI, number, target)
+	payload := map[string]any{
+		"targetRefName": refName(target),
+	}
+	err := self.request(http.MethodPatch, self.apiURL(fmt.Sprintf("/%d", number), nil), payload, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// apiURL provides the URL of the given path below the pull requests endpoint of this repository,
+// with the given query parameters.
+func (self *Connector) apiURL(path string, params url.Values) string {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("api-version", apiVersion)
+	return fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests%s?%s", self.BaseURL, self.Organization, url.PathEscape(self.Repository), path, params.Encode())
+}
+
+// completeProposal completes the proposal with the given number using the given Azure DevOps merge strategy.
+func (self *Connector) completeProposal(number int, message, mergeStrategy string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingAzureDevOpsMergingViaAPI, number)
+	pullRequestURL := self.apiURL(fmt.Sprintf("/%d", number), nil)
+	// Azure DevOps only completes pull requests when given the last commit of the source branch that the client knows about
+	var current pullRequest
+	err := self.request(http.MethodGet, pullRequestURL, nil, &current)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	completionOptions := map[string]any{
+		"deleteSourceBranch": false,
+		"mergeStrategy":      mergeStrategy,
+	}
+	if message != "" {
+		completionOptions["mergeCommitMessage"] = message
+	}
+	payload := map[string]any{
+		"completionOptions":     completionOptions,
+		"lastMergeSourceCommit": current.LastMergeSourceCommit,
+		"status":                "completed",
+	}
+	err = self.request(http.MethodPatch, pullRequestURL, payload, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// request sends the given payload as JSON to the given API URL
+// and decodes the JSON response into the given result if it isn't nil.
+func (self *Connector) request(method, apiURL string, payload, result any) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, apiURL, body) //nolint:noctx
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if self.APIToken != "" {
+		// Azure DevOps expects personal access tokens as the password of basic authentication with an empty username
+		request.SetBasicAuth("", self.APIToken.String())
+	}
+	response, err := self.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	responseData, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf(messages.HostingAzureDevOpsAPIError, response.StatusCode, parseErrorMessage(responseData))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(responseData, result)
+}
+
+// refName provides the fully qualified Git ref of the given branch.
+func refName(branch gitdomain.LocalBranchName) string {
+	return refPrefix + branch.String()
+}
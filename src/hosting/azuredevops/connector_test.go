@@ -0,0 +1,246 @@
+package azuredevops_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/azuredevops"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/test/hostingapi"
+	"github.com/shoenig/test/must"
+)
+
+func TestAzureDevOpsConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewConnector", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("HTTPS remote", func(t *testing.T) {
+			t.Parallel()
+			have, err := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+				APIToken:        "",
+				HostingPlatform: configdomain.HostingPlatformNone,
+				Log:             print.Logger{},
+				OriginURL:       giturl.Parse("https://org@dev.azure.com/org/project/_git/repo"),
+			})
+			must.NoError(t, err)
+			wantConfig := hostingdomain.Config{
+				Hostname:     "dev.azure.com",
+				Organization: "org/project",
+				Repository:   "repo",
+			}
+			must.EqOp(t, wantConfig, have.Config)
+			must.EqOp(t, "https://dev.azure.com", have.BaseURL)
+		})
+
+		t.Run("SSH remote", func(t *testing.T) {
+			t.Parallel()
+			have, err := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+				APIToken:        "",
+				HostingPlatform: configdomain.HostingPlatformNone,
+				Log:             print.Logger{},
+				OriginURL:       giturl.Parse("git@ssh.dev.azure.com:v3/org/project/repo"),
+			})
+			must.NoError(t, err)
+			wantConfig := hostingdomain.Config{
+				Hostname:     "dev.azure.com",
+				Organization: "org/project",
+				Repository:   "repo",
+			}
+			must.EqOp(t, wantConfig, have.Config)
+			must.EqOp(t, "https://dev.azure.com", have.BaseURL)
+		})
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		connector, err := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:        "",
+			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("git@ssh.dev.azure.com:v3/org/project/repo"),
+		})
+		must.NoError(t, err)
+		have, err := connector.NewProposalURL("feature/one", gitdomain.NewLocalBranchName("parent-branch"))
+		must.NoError(t, err)
+		want := "https://dev.azure.com/org/project/_git/repo/pullrequestcreate?sourceRef=feature%2Fone&targetRef=parent-branch"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		connector, err := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:        "",
+			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("git@ssh.dev.azure.com:v3/org/project/repo"),
+		})
+		must.NoError(t, err)
+		must.EqOp(t, "https://dev.azure.com/org/project/_git/repo", connector.RepositoryURL())
+	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("open pull request to the given target", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+				must.EqOp(t, http.MethodGet, request.Method)
+				must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests", request.URL.Path)
+				must.EqOp(t, "7.0", request.URL.Query().Get("api-version"))
+				must.EqOp(t, "refs/heads/feature", request.URL.Query().Get("searchCriteria.sourceRefName"))
+				must.EqOp(t, "refs/heads/parent", request.URL.Query().Get("searchCriteria.targetRefName"))
+				must.EqOp(t, "active", request.URL.Query().Get("searchCriteria.status"))
+				username, password, ok := request.BasicAuth()
+				must.True(t, ok)
+				must.EqOp(t, "", username)
+				must.EqOp(t, "secret", password)
+				fmt.Fprint(writer, `{"count": 1, "value": [
+					{
+						"pullRequestId": 12,
+						"title": "my title",
+						"description": "my description",
+						"targetRefName": "refs/heads/parent"
+					}
+				]}`)
+			})
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.NoError(t, err)
+			want := &hostingdomain.Proposal{
+				Body:         "my description",
+				MergeWithAPI: true,
+				Number:       12,
+				Target:       gitdomain.NewLocalBranchName("parent"),
+				Title:        "my title",
+				URL:          "https://dev.azure.com/org/project/_git/repo/pullrequest/12",
+			}
+			must.Eq(t, want, have)
+		})
+
+		t.Run("no open pull request", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, func(writer http.ResponseWriter, _ *http.Request) {
+				fmt.Fprint(writer, `{"count": 0, "value": []}`)
+			})
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.NoError(t, err)
+			must.Nil(t, have)
+		})
+
+		t.Run("multiple open pull requests", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, func(writer http.ResponseWriter, _ *http.Request) {
+				fmt.Fprint(writer, `{"count": 2, "value": [{"pullRequestId": 11}, {"pullRequestId": 12}]}`)
+			})
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.Error(t, err)
+		})
+
+		t.Run("API error", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, func(writer http.ResponseWriter, _ *http.Request) {
+				writer.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(writer, `{"message": "TF401019: The Git repository does not exist."}`)
+			})
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.EqError(t, err, "Azure DevOps API responded with status 404: TF401019: The Git repository does not exist.")
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests/12", request.URL.Path)
+			switch request.Method {
+			case http.MethodGet:
+				fmt.Fprint(writer, `{"pullRequestId": 12, "lastMergeSourceCommit": {"commitId": "abc123"}}`)
+			case http.MethodPatch:
+				must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+				fmt.Fprint(writer, `{"pullRequestId": 12, "status": "completed"}`)
+			}
+		})
+		err := connector.SquashMergeProposal(12, "title\n\nbody")
+		must.NoError(t, err)
+		want := map[string]any{
+			"completionOptions": map[string]any{
+				"deleteSourceBranch": false,
+				"mergeCommitMessage": "title\n\nbody",
+				"mergeStrategy":      "squash",
+			},
+			"lastMergeSourceCommit": map[string]any{
+				"commitId": "abc123",
+			},
+			"status": "completed",
+		}
+		must.Eq(t, want, haveBody)
+	})
+
+	t.Run("SquashMergeProposal without number", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, func(_ http.ResponseWriter, _ *http.Request) {
+			t.Fatal("unexpected API call")
+		})
+		err := connector.SquashMergeProposal(0, "message")
+		must.Error(t, err)
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodPatch, request.Method)
+			must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests/12", request.URL.Path)
+			must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+			fmt.Fprint(writer, `{"pullRequestId": 12}`)
+		})
+		err := connector.UpdateProposalTarget(12, gitdomain.NewLocalBranchName("new-target"))
+		must.NoError(t, err)
+		want := map[string]any{
+			"targetRefName": "refs/heads/new-target",
+		}
+		must.Eq(t, want, haveBody)
+	})
+
+	t.Run("CloseProposal", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodPatch, request.Method)
+			must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests/12", request.URL.Path)
+			must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+			fmt.Fprint(writer, `{"pullRequestId": 12, "status": "abandoned"}`)
+		})
+		err := connector.CloseProposal(12)
+		must.NoError(t, err)
+		want := map[string]any{
+			"status": "abandoned",
+		}
+		must.Eq(t, want, haveBody)
+	})
+}
+
+// newTestConnector provides an Azure DevOps connector that talks to an API stand-in served by the given handler.
+func newTestConnector(t *testing.T, handler http.HandlerFunc) *azuredevops.Connector {
+	t.Helper()
+	return hostingapi.NewConnector(t, handler, func(url string) (*azuredevops.Connector, error) {
+		connector, err := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:        "secret",
+			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("https://dev.azure.com/org/project/_git/repo"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		connector.BaseURL = url
+		return connector, nil
+	})
+}
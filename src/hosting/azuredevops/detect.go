@@ -0,0 +1,11 @@
+package azuredevops
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+)
+
+// Detect indicates whether the current repository is hosted on Azure DevOps.
+func Detect(originURL *giturl.Parts, hostingPlatform configdomain.HostingPlatform) bool {
+	return originURL != nil && (originURL.Host == "dev.azure.com" || originURL.Host == "ssh.dev.azure.com" || hostingPlatform == configdomain.HostingPlatformAzureDevOps)
+}
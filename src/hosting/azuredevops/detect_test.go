@@ -0,0 +1,40 @@
+package azuredevops_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/azuredevops"
+	"github.com/shoenig/test/must"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("HTTPS remote", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, azuredevops.Detect(giturl.Parse("https://dev.azure.com/git-town/git-town/_git/git-town"), configdomain.HostingPlatformNone))
+	})
+
+	t.Run("SSH remote", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, azuredevops.Detect(giturl.Parse("git@ssh.dev.azure.com:v3/git-town/git-town/git-town"), configdomain.HostingPlatformNone))
+	})
+
+	t.Run("hosted service type provided manually", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, azuredevops.Detect(giturl.Parse("https://tfs.example.com/tfs/collection/project/_git/repo"), configdomain.HostingPlatformAzureDevOps))
+	})
+
+	t.Run("repo is hosted by another hosting platform", func(t *testing.T) {
+		t.Parallel()
+		must.False(t, azuredevops.Detect(giturl.Parse("git@github.com:git-town/git-town.git"), configdomain.HostingPlatformNone))
+	})
+
+	t.Run("no origin remote", func(t *testing.T) {
+		t.Parallel()
+		var originURL *giturl.Parts
+		must.False(t, azuredevops.Detect(originURL, configdomain.HostingPlatformAzureDevOps))
+	})
+}
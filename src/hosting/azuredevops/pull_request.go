@@ -0,0 +1,55 @@
+package azuredevops
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+)
+
+// pullRequest contains the data of a pull request returned by the Azure DevOps API.
+type pullRequest struct {
+	Description           string `json:"description"`
+	LastMergeSourceCommit commit `json:"lastMergeSourceCommit"`
+	PullRequestID         int    `json:"pullRequestId"`
+	TargetRefName         string `json:"targetRefName"`
+	Title                 string `json:"title"`
+}
+
+// proposal provides the standardized proposal data of this pull request
+// in the repository with the given web URL.
+func (self pullRequest) proposal(repositoryURL string) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         self.Description,
+		MergeWithAPI: true,
+		Number:       self.PullRequestID,
+		Target:       gitdomain.NewLocalBranchName(strings.TrimPrefix(self.TargetRefName, refPrefix)),
+		Title:        self.Title,
+		URL:          fmt.Sprintf("%s/pullrequest/%d", repositoryURL, self.PullRequestID),
+	}
+}
+
+// pullRequestList contains the pull requests returned by the Azure DevOps API.
+type pullRequestList struct {
+	Value []pullRequest `json:"value"`
+}
+
+type commit struct {
+	CommitID string `json:"commitId"`
+}
+
+// errorResponse contains the error details that the Azure DevOps API returns for failed requests.
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+// parseErrorMessage provides the error message contained in the given response body of a failed API request.
+func parseErrorMessage(responseData []byte) string {
+	var response errorResponse
+	if err := json.Unmarshal(responseData, &response); err == nil && response.Message != "" {
+		return response.Message
+	}
+	return strings.TrimSpace(string(responseData))
+}
@@ -1,10 +1,15 @@
 package bitbucket
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 
+	"github.com/git-town/git-town/v12/src/cli/print"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/git/giturl"
@@ -12,34 +17,106 @@ import (
 	"github.com/git-town/git-town/v12/src/messages"
 )
 
+// DefaultAPIURL is the base URL of the Bitbucket Cloud REST API.
+const DefaultAPIURL = "https://api.bitbucket.org/2.0"
+
 // Connector provides access to the API of Bitbucket installations.
 type Connector struct {
 	hostingdomain.Config
+	APIURL      string // base URL of the REST API, tests point this to a stand-in server
+	AppPassword configdomain.BitbucketAppPassword
+	Username    configdomain.BitbucketUsername
+	client      *http.Client
+	log         print.Logger
 }
 
 // NewConnector provides a Bitbucket connector instance if the current repo is hosted on Bitbucket,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) (*Connector, error) {
 	return &Connector{
+		APIURL:      DefaultAPIURL,
+		AppPassword: args.AppPassword,
 		Config: hostingdomain.Config{
 			Hostname:     args.OriginURL.Host,
 			Organization: args.OriginURL.Org,
 			Repository:   args.OriginURL.Repo,
 		},
+		Username: args.Username,
+		client:   &http.Client{},
+		log:      args.Log,
 	}, nil
 }
 
 type NewConnectorArgs struct {
+	AppPassword     configdomain.BitbucketAppPassword
 	HostingPlatform configdomain.HostingPlatform
+	Log             print.Logger
 	OriginURL       *giturl.Parts
+	Username        configdomain.BitbucketUsername
+}
+
+func (self *Connector) CloseProposal(number int) error {
+	self.log.Start(messages.HostingBitbucketClosePRViaAPI, number)
+	err := self.request(http.MethodPost, self.pullRequestURL(number)+"/decline", nil, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingBitbucketCreatingPRViaAPI, branch)
+	payload := map[string]any{
+		"title":       title,
+		"description": body,
+		"source":      newEndpoint(branch),
+		"destination": newEndpoint(target),
+		"draft":       draft,
+	}
+	var created pullRequest
+	err := self.request(http.MethodPost, self.pullRequestsURL(), payload, &created)
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //nolint:exhaustruct
+	}
+	self.log.Success()
+	return created.proposal(), nil
 }
 
 func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
-func (self *Connector) FindProposal(_, _ gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
-	return nil, errors.New(messages.HostingBitBucketNotImplemented)
+func (self *Connector) FastForwardProposal(number int) error {
+	return self.mergeProposal(number, "", "fast_forward")
+}
+
+func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	query := fmt.Sprintf(`source.branch.name = %q AND state = "OPEN"`, branch)
+	if !target.IsEmpty() {
+		query += fmt.Sprintf(` AND destination.branch.name = %q`, target)
+	}
+	params := url.Values{}
+	params.Set("q", query)
+	var response pullRequestsPage
+	err := self.request(http.MethodGet, self.pullRequestsURL()+"?"+params.Encode(), nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Values) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	if len(response.Values) > 1 {
+		return nil, fmt.Errorf(messages.ProposalMultipleFound, len(response.Values), branch, target)
+	}
+	proposal := response.Values[0].proposal()
+	return &proposal, nil
+}
+
+func (self *Connector) MergeCommitProposal(number int, message string) error {
+	return self.mergeProposal(number, message, "merge_commit")
 }
 
 func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
@@ -56,10 +133,106 @@ func (self *Connector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
 }
 
-func (self *Connector) SquashMergeProposal(_ int, _ string) error {
-	return errors.New(messages.HostingBitBucketNotImplemented)
+func (self *Connector) SquashMergeProposal(number int, message string) error {
+	return self.mergeProposal(number, message, "squash")
+}
+
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingBitbucketUpdatePRBodyViaAPI, number)
+	payload := map[string]any{
+		"description": body,
+	}
+	err := self.request(http.MethodPut, self.pullRequestURL(number), payload, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingBitbucketUpdatePRViaAPI, number, target)
+	payload := map[string]any{
+		"destination": newEndpoint(target),
+	}
+	err := self.request(http.MethodPut, self.pullRequestURL(number), payload, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// mergeProposal merges the proposal with the given number using the given Bitbucket merge strategy.
+func (self *Connector) mergeProposal(number int, message, mergeStrategy string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingBitbucketMergingViaAPI, number)
+	payload := map[string]any{
+		"close_source_branch": false,
+		"merge_strategy":      mergeStrategy,
+	}
+	if message != "" {
+		payload["message"] = message
+	}
+	err := self.request(http.MethodPost, self.pullRequestURL(number)+"/merge", payload, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// pullRequestURL provides the API URL of the pull request with the given number.
+func (self *Connector) pullRequestURL(number int) string {
+	return fmt.Sprintf("%s/%d", self.pullRequestsURL(), number)
+}
+
+// pullRequestsURL provides the API URL of the pull requests of this repository.
+func (self *Connector) pullRequestsURL() string {
+	return fmt.Sprintf("%s/repositories/%s/%s/pullrequests", self.APIURL, url.PathEscape(self.Organization), url.PathEscape(self.Repository))
 }
 
-func (self *Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
-	return errors.New(messages.HostingBitBucketNotImplemented)
+// request sends the given payload as JSON to the given API URL
+// and decodes the JSON response into the given result if it isn't nil.
+func (self *Connector) request(method, apiURL string, payload, result any) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, apiURL, body) //nolint:noctx
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if self.Username != "" {
+		request.SetBasicAuth(self.Username.String(), self.AppPassword.String())
+	}
+	response, err := self.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	responseData, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf(messages.HostingBitbucketAPIError, response.StatusCode, parseErrorMessage(responseData))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(responseData, result)
 }
@@ -1,13 +1,18 @@
 package bitbucket_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/git-town/git-town/v12/src/cli/print"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/git/giturl"
 	"github.com/git-town/git-town/v12/src/hosting/bitbucket"
 	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/test/hostingapi"
 	"github.com/shoenig/test/must"
 )
 
@@ -20,8 +25,11 @@ func TestBitbucketConnector(t *testing.T) {
 		t.Run("Bitbucket SaaS", func(t *testing.T) {
 			t.Parallel()
 			have, err := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+				AppPassword:     "",
 				HostingPlatform: configdomain.HostingPlatformNone,
+				Log:             print.Logger{},
 				OriginURL:       giturl.Parse("username@bitbucket.org:git-town/docs.git"),
+				Username:        "",
 			})
 			must.NoError(t, err)
 			wantConfig := hostingdomain.Config{
@@ -35,8 +43,11 @@ func TestBitbucketConnector(t *testing.T) {
 		t.Run("hosted service type provided manually", func(t *testing.T) {
 			t.Parallel()
 			have, err := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+				AppPassword:     "",
 				HostingPlatform: configdomain.HostingPlatformBitbucket,
+				Log:             print.Logger{},
 				OriginURL:       giturl.Parse("git@custom-url.com:git-town/docs.git"),
+				Username:        "",
 			})
 			must.NoError(t, err)
 			wantConfig := hostingdomain.Config{
@@ -51,8 +62,11 @@ func TestBitbucketConnector(t *testing.T) {
 	t.Run("NewProposalURL", func(t *testing.T) {
 		t.Parallel()
 		connector, err := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+			AppPassword:     "",
 			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
 			OriginURL:       giturl.Parse("username@bitbucket.org:org/repo.git"),
+			Username:        "",
 		})
 		must.NoError(t, err)
 		have, err := connector.NewProposalURL("branch", gitdomain.NewLocalBranchName("parent-branch"))
@@ -60,4 +74,178 @@ func TestBitbucketConnector(t *testing.T) {
 		want := "https://bitbucket.org/org/repo/pull-requests/new?source=branch&dest=org%2Frepo%3Aparent-branch"
 		must.EqOp(t, want, have)
 	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("one open pull request", func(t *testing.T) {
+			t.Parallel()
+			var haveQuery string
+			connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+				must.EqOp(t, http.MethodGet, request.Method)
+				must.EqOp(t, "/repositories/org/repo/pullrequests", request.URL.Path)
+				haveQuery = request.URL.Query().Get("q")
+				username, password, ok := request.BasicAuth()
+				must.True(t, ok)
+				must.EqOp(t, "user", username)
+				must.EqOp(t, "secret", password)
+				fmt.Fprint(writer, `{"values": [{
+					"id": 12,
+					"title": "my title",
+					"description": "my description",
+					"destination": {"branch": {"name": "parent"}},
+					"links": {"html": {"href": "https://bitbucket.org/org/repo/pull-requests/12"}}
+				}]}`)
+			})
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.NoError(t, err)
+			want := &hostingdomain.Proposal{
+				Body:         "my description",
+				MergeWithAPI: true,
+				Number:       12,
+				Target:       gitdomain.NewLocalBranchName("parent"),
+				Title:        "my title",
+				URL:          "https://bitbucket.org/org/repo/pull-requests/12",
+			}
+			must.Eq(t, want, have)
+			must.EqOp(t, `source.branch.name = "feature" AND state = "OPEN" AND destination.branch.name = "parent"`, haveQuery)
+		})
+
+		t.Run("no target branch given", func(t *testing.T) {
+			t.Parallel()
+			var haveQuery string
+			connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+				haveQuery = request.URL.Query().Get("q")
+				fmt.Fprint(writer, `{"values": []}`)
+			})
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.EmptyLocalBranchName())
+			must.NoError(t, err)
+			must.Nil(t, have)
+			must.EqOp(t, `source.branch.name = "feature" AND state = "OPEN"`, haveQuery)
+		})
+
+		t.Run("multiple open pull requests", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, func(writer http.ResponseWriter, _ *http.Request) {
+				fmt.Fprint(writer, `{"values": [{"id": 1}, {"id": 2}]}`)
+			})
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.Error(t, err)
+		})
+
+		t.Run("API error", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, func(writer http.ResponseWriter, _ *http.Request) {
+				writer.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(writer, `{"type": "error", "error": {"message": "Invalid credentials"}}`)
+			})
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.EqError(t, err, "Bitbucket API responded with status 401: Invalid credentials")
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("merges the pull request", func(t *testing.T) {
+			t.Parallel()
+			var haveBody map[string]any
+			connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+				must.EqOp(t, http.MethodPost, request.Method)
+				must.EqOp(t, "/repositories/org/repo/pullrequests/12/merge", request.URL.Path)
+				must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+				fmt.Fprint(writer, `{"id": 12, "state": "MERGED"}`)
+			})
+			err := connector.SquashMergeProposal(12, "title\n\nbody")
+			must.NoError(t, err)
+			want := map[string]any{
+				"close_source_branch": false,
+				"merge_strategy":      "squash",
+				"message":             "title\n\nbody",
+			}
+			must.Eq(t, want, haveBody)
+		})
+
+		t.Run("no pull request number given", func(t *testing.T) {
+			t.Parallel()
+			apiCalled := false
+			connector := newTestConnector(t, func(_ http.ResponseWriter, _ *http.Request) {
+				apiCalled = true
+			})
+			err := connector.SquashMergeProposal(0, "title")
+			must.Error(t, err)
+			must.False(t, apiCalled)
+		})
+	})
+
+	t.Run("UpdateProposalBody", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodPut, request.Method)
+			must.EqOp(t, "/repositories/org/repo/pullrequests/12", request.URL.Path)
+			must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+			fmt.Fprint(writer, `{"id": 12}`)
+		})
+		err := connector.UpdateProposalBody(12, "new description")
+		must.NoError(t, err)
+		want := map[string]any{
+			"description": "new description",
+		}
+		must.Eq(t, want, haveBody)
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodPut, request.Method)
+			must.EqOp(t, "/repositories/org/repo/pullrequests/12", request.URL.Path)
+			must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+			fmt.Fprint(writer, `{"id": 12}`)
+		})
+		err := connector.UpdateProposalTarget(12, gitdomain.NewLocalBranchName("new-target"))
+		must.NoError(t, err)
+		want := map[string]any{
+			"destination": map[string]any{
+				"branch": map[string]any{
+					"name": "new-target",
+				},
+			},
+		}
+		must.Eq(t, want, haveBody)
+	})
+
+	t.Run("CloseProposal", func(t *testing.T) {
+		t.Parallel()
+		called := false
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodPost, request.Method)
+			must.EqOp(t, "/repositories/org/repo/pullrequests/12/decline", request.URL.Path)
+			called = true
+			fmt.Fprint(writer, `{"id": 12, "state": "DECLINED"}`)
+		})
+		err := connector.CloseProposal(12)
+		must.NoError(t, err)
+		must.True(t, called)
+	})
+}
+
+// newTestConnector provides a Bitbucket connector that talks to an API stand-in served by the given handler.
+func newTestConnector(t *testing.T, handler http.HandlerFunc) *bitbucket.Connector {
+	t.Helper()
+	return hostingapi.NewConnector(t, handler, func(url string) (*bitbucket.Connector, error) {
+		connector, err := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+			AppPassword:     "secret",
+			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("git@bitbucket.org:org/repo.git"),
+			Username:        "user",
+		})
+		if err != nil {
+			return nil, err
+		}
+		connector.APIURL = url
+		return connector, nil
+	})
 }
@@ -0,0 +1,72 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+)
+
+// pullRequest contains the data of a pull request returned by the Bitbucket API.
+type pullRequest struct {
+	Description string   `json:"description"`
+	Destination endpoint `json:"destination"`
+	ID          int      `json:"id"`
+	Links       links    `json:"links"`
+	Title       string   `json:"title"`
+}
+
+// proposal provides the standardized proposal data of this pull request.
+func (self pullRequest) proposal() hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         self.Description,
+		MergeWithAPI: true,
+		Number:       self.ID,
+		Target:       gitdomain.NewLocalBranchName(self.Destination.Branch.Name),
+		Title:        self.Title,
+		URL:          self.Links.HTML.Href,
+	}
+}
+
+// pullRequestsPage contains a page of pull requests returned by the Bitbucket API.
+type pullRequestsPage struct {
+	Values []pullRequest `json:"values"`
+}
+
+// endpoint describes the source or destination of a pull request.
+type endpoint struct {
+	Branch branch `json:"branch"`
+}
+
+func newEndpoint(branchName gitdomain.LocalBranchName) endpoint {
+	return endpoint{Branch: branch{Name: branchName.String()}}
+}
+
+type branch struct {
+	Name string `json:"name"`
+}
+
+type links struct {
+	HTML link `json:"html"`
+}
+
+type link struct {
+	Href string `json:"href"`
+}
+
+// errorResponse contains the error details that the Bitbucket API returns for failed requests.
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// parseErrorMessage provides the error message contained in the given response body of a failed API request.
+func parseErrorMessage(responseData []byte) string {
+	var response errorResponse
+	if err := json.Unmarshal(responseData, &response); err == nil && response.Error.Message != "" {
+		return response.Error.Message
+	}
+	return strings.TrimSpace(string(responseData))
+}
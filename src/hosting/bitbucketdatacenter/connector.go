@@ -0,0 +1,291 @@
+package bitbucketdatacenter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// Connector provides access to the API of Bitbucket Data Center and Bitbucket Server installations.
+type Connector struct {
+	hostingdomain.Config
+	APIToken configdomain.BitbucketDatacenterToken
+	BaseURL  string // URL of the server, tests point this to a stand-in server
+	client   *http.Client
+	log      print.Logger
+}
+
+// NewConnector provides a Bitbucket Data Center connector instance if the current repo is hosted on Bitbucket Data Center,
+// otherwise nil.
+func NewConnector(args NewConnectorArgs) (*Connector, error) {
+	// HTTPS remotes of Bitbucket Data Center have the form https://<host>/scm/<project>/<repo>.git
+	hostname := strings.TrimSuffix(args.OriginURL.Host, "/scm")
+	connector := Connector{
+		APIToken: args.APIToken,
+		BaseURL:  "",
+		Config: hostingdomain.Config{
+			Hostname:     hostname,
+			Organization: args.OriginURL.Org,
+			Repository:   args.OriginURL.Repo,
+		},
+		client: &http.Client{},
+		log:    args.Log,
+	}
+	connector.BaseURL = "https://" + connector.HostnameWithStandardPort()
+	return &connector, nil
+}
+
+type NewConnectorArgs struct {
+	APIToken        configdomain.BitbucketDatacenterToken
+	HostingPlatform configdomain.HostingPlatform
+	Log             print.Logger
+	OriginURL       *giturl.Parts
+}
+
+func (self *Connector) CloseProposal(number int) error {
+	self.log.Start(messages.HostingBitbucketClosePRViaAPI, number)
+	// Bitbucket Data Center only declines the version of the pull request that the client knows about
+	current, err := self.loadPullRequest(number)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	declineURL := fmt.Sprintf("%s/decline?version=%d", self.pullRequestURL(number), current.Version)
+	err = self.request(http.MethodPost, declineURL, nil, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingBitbucketCreatingPRViaAPI, branch)
+	payload := map[string]any{
+		"title":       title,
+		"description": body,
+		"draft":       draft,
+		"fromRef":     self.newRef(branch),
+		"toRef":       self.newRef(target),
+	}
+	var created pullRequest
+	err := self.request(http.MethodPost, self.pullRequestsURL(), payload, &created)
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //nolint:exhaustruct
+	}
+	self.log.Success()
+	return created.proposal(), nil
+}
+
+func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
+}
+
+func (self *Connector) FastForwardProposal(number int) error {
+	return self.mergeProposal(number, "", "ff-only")
+}
+
+func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	params := url.Values{}
+	params.Set("at", refID(branch))
+	params.Set("direction", "OUTGOING")
+	params.Set("state", "OPEN")
+	var response pullRequestsPage
+	err := self.request(http.MethodGet, self.pullRequestsURL()+"?"+params.Encode(), nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	pullRequests := []pullRequest{}
+	for _, candidate := range response.Values {
+		if target.IsEmpty() || candidate.ToRef.DisplayID == target.String() {
+			pullRequests = append(pullRequests, candidate)
+		}
+	}
+	if len(pullRequests) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	if len(pullRequests) > 1 {
+		return nil, fmt.Errorf(messages.ProposalMultipleFound, len(pullRequests), branch, target)
+	}
+	proposal := pullRequests[0].proposal()
+	return &proposal, nil
+}
+
+func (self *Connector) MergeCommitProposal(number int, message string) error {
+	return self.mergeProposal(number, message, "no-ff")
+}
+
+func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
+	return fmt.Sprintf("%s/pull-requests?create&sourceBranch=%s&targetBranch=%s",
+			self.RepositoryURL(),
+			url.QueryEscape(refID(branch)),
+			url.QueryEscape(refID(parentBranch))),
+		nil
+}
+
+func (self *Connector) RepositoryURL() string {
+	return fmt.Sprintf("https://%s/projects/%s/repos/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
+}
+
+func (self *Connector) SquashMergeProposal(number int, message string) error {
+	return self.mergeProposal(number, message, "squash")
+}
+
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingBitbucketUpdatePRBodyViaAPI, number)
+	err := self.updatePullRequest(number, func(payload map[string]any) {
+		payload["description"] = body
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingBitbucketUpdatePRViaAPI, number, target)
+	err := self.updatePullRequest(number, func(payload map[string]any) {
+		payload["toRef"] = self.newRef(target)
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// loadPullRequest provides the pull request with the given number.
+func (self *Connector) loadPullRequest(number int) (pullRequest, error) {
+	var result pullRequest
+	err := self.request(http.MethodGet, self.pullRequestURL(number), nil, &result)
+	return result, err
+}
+
+// mergeProposal merges the proposal with the given number using the given Bitbucket merge strategy.
+func (self *Connector) mergeProposal(number int, message, mergeStrategy string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingBitbucketMergingViaAPI, number)
+	// Bitbucket Data Center only merges the version of the pull request that the client knows about
+	current, err := self.loadPullRequest(number)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	payload := map[string]any{
+		"strategyId": mergeStrategy,
+	}
+	if message != "" {
+		payload["message"] = message
+	}
+	mergeURL := fmt.Sprintf("%s/merge?version=%d", self.pullRequestURL(number), current.Version)
+	err = self.request(http.MethodPost, mergeURL, payload, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// newRef provides the API representation of the given branch in this repository.
+func (self *Connector) newRef(branch gitdomain.LocalBranchName) ref {
+	return ref{
+		DisplayID: "",
+		ID:        refID(branch),
+		Repository: &repository{
+			Project: project{Key: self.Organization},
+			Slug:    self.Repository,
+		},
+	}
+}
+
+// pullRequestURL provides the API URL of the pull request with the given number.
+func (self *Connector) pullRequestURL(number int) string {
+	return fmt.Sprintf("%s/%d", self.pullRequestsURL(), number)
+}
+
+// pullRequestsURL provides the API URL of the pull requests of this repository.
+func (self *Connector) pullRequestsURL() string {
+	return fmt.Sprintf("%s/rest/api/1.0/projects/%s/repos/%s/pull-requests", self.BaseURL, url.PathEscape(self.Organization), url.PathEscape(self.Repository))
+}
+
+// request sends the given payload as JSON to the given API URL
+// and decodes the JSON response into the given result if it isn't nil.
+func (self *Connector) request(method, apiURL string, payload, result any) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, apiURL, body) //nolint:noctx
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if self.APIToken != "" {
+		request.Header.Set("Authorization", "Bearer "+self.APIToken.String())
+	}
+	response, err := self.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	responseData, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf(messages.HostingBitbucketAPIError, response.StatusCode, parseErrorMessage(responseData))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(responseData, result)
+}
+
+// updatePullRequest changes the pull request with the given number.
+// Bitbucket Data Center requires the current version, title, and description in every update,
+// the given function applies the changes on top of them.
+func (self *Connector) updatePullRequest(number int, change func(map[string]any)) error {
+	current, err := self.loadPullRequest(number)
+	if err != nil {
+		return err
+	}
+	payload := map[string]any{
+		"description": current.Description,
+		"title":       current.Title,
+		"version":     current.Version,
+	}
+	change(payload)
+	return self.request(http.MethodPut, self.pullRequestURL(number), payload, nil)
+}
+
+// refID provides the fully qualified Git ref of the given branch.
+func refID(branch gitdomain.LocalBranchName) string {
+	return "refs/heads/" + branch.String()
+}
@@ -0,0 +1,240 @@
+package bitbucketdatacenter_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/bitbucketdatacenter"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/test/hostingapi"
+	"github.com/shoenig/test/must"
+)
+
+func TestBitbucketDatacenterConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewConnector", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("SSH remote", func(t *testing.T) {
+			t.Parallel()
+			have, err := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+				APIToken:        "",
+				HostingPlatform: configdomain.HostingPlatformBitbucketDatacenter,
+				Log:             print.Logger{},
+				OriginURL:       giturl.Parse("ssh://git@bitbucket.example.com:7999/proj/repo.git"),
+			})
+			must.NoError(t, err)
+			wantConfig := hostingdomain.Config{
+				Hostname:     "bitbucket.example.com:7999",
+				Organization: "proj",
+				Repository:   "repo",
+			}
+			must.EqOp(t, wantConfig, have.Config)
+			must.EqOp(t, "https://bitbucket.example.com", have.BaseURL)
+		})
+
+		t.Run("HTTPS remote", func(t *testing.T) {
+			t.Parallel()
+			have, err := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+				APIToken:        "",
+				HostingPlatform: configdomain.HostingPlatformBitbucketDatacenter,
+				Log:             print.Logger{},
+				OriginURL:       giturl.Parse("https://bitbucket.example.com/scm/proj/repo.git"),
+			})
+			must.NoError(t, err)
+			wantConfig := hostingdomain.Config{
+				Hostname:     "bitbucket.example.com",
+				Organization: "proj",
+				Repository:   "repo",
+			}
+			must.EqOp(t, wantConfig, have.Config)
+		})
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		connector, err := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+			APIToken:        "",
+			HostingPlatform: configdomain.HostingPlatformBitbucketDatacenter,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("ssh://git@bitbucket.example.com:7999/proj/repo.git"),
+		})
+		must.NoError(t, err)
+		have, err := connector.NewProposalURL("branch", gitdomain.NewLocalBranchName("parent-branch"))
+		must.NoError(t, err)
+		want := "https://bitbucket.example.com/projects/proj/repos/repo/pull-requests?create&sourceBranch=refs%2Fheads%2Fbranch&targetBranch=refs%2Fheads%2Fparent-branch"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		connector, err := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+			APIToken:        "",
+			HostingPlatform: configdomain.HostingPlatformBitbucketDatacenter,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("ssh://git@bitbucket.example.com:7999/proj/repo.git"),
+		})
+		must.NoError(t, err)
+		must.EqOp(t, "https://bitbucket.example.com/projects/proj/repos/repo", connector.RepositoryURL())
+	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("open pull request to the given target", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+				must.EqOp(t, http.MethodGet, request.Method)
+				must.EqOp(t, "/rest/api/1.0/projects/proj/repos/repo/pull-requests", request.URL.Path)
+				must.EqOp(t, "refs/heads/feature", request.URL.Query().Get("at"))
+				must.EqOp(t, "OUTGOING", request.URL.Query().Get("direction"))
+				must.EqOp(t, "OPEN", request.URL.Query().Get("state"))
+				must.EqOp(t, "Bearer secret", request.Header.Get("Authorization"))
+				fmt.Fprint(writer, `{"values": [
+					{"id": 11, "toRef": {"displayId": "other"}},
+					{
+						"id": 12,
+						"version": 3,
+						"title": "my title",
+						"description": "my description",
+						"toRef": {"id": "refs/heads/parent", "displayId": "parent"},
+						"links": {"self": [{"href": "https://bitbucket.example.com/projects/proj/repos/repo/pull-requests/12"}]}
+					}
+				]}`)
+			})
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.NoError(t, err)
+			want := &hostingdomain.Proposal{
+				Body:         "my description",
+				MergeWithAPI: true,
+				Number:       12,
+				Target:       gitdomain.NewLocalBranchName("parent"),
+				Title:        "my title",
+				URL:          "https://bitbucket.example.com/projects/proj/repos/repo/pull-requests/12",
+			}
+			must.Eq(t, want, have)
+		})
+
+		t.Run("no open pull request", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, func(writer http.ResponseWriter, _ *http.Request) {
+				fmt.Fprint(writer, `{"values": [{"id": 11, "toRef": {"displayId": "other"}}]}`)
+			})
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.NoError(t, err)
+			must.Nil(t, have)
+		})
+
+		t.Run("API error", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, func(writer http.ResponseWriter, _ *http.Request) {
+				writer.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(writer, `{"errors": [{"message": "Authentication failed"}]}`)
+			})
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"))
+			must.EqError(t, err, "Bitbucket API responded with status 401: Authentication failed")
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+		var haveQuery string
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			switch request.Method {
+			case http.MethodGet:
+				must.EqOp(t, "/rest/api/1.0/projects/proj/repos/repo/pull-requests/12", request.URL.Path)
+				fmt.Fprint(writer, `{"id": 12, "version": 3}`)
+			case http.MethodPost:
+				must.EqOp(t, "/rest/api/1.0/projects/proj/repos/repo/pull-requests/12/merge", request.URL.Path)
+				haveQuery = request.URL.RawQuery
+				must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+				fmt.Fprint(writer, `{"id": 12, "state": "MERGED"}`)
+			}
+		})
+		err := connector.SquashMergeProposal(12, "title\n\nbody")
+		must.NoError(t, err)
+		must.EqOp(t, "version=3", haveQuery)
+		want := map[string]any{
+			"message":    "title\n\nbody",
+			"strategyId": "squash",
+		}
+		must.Eq(t, want, haveBody)
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, "/rest/api/1.0/projects/proj/repos/repo/pull-requests/12", request.URL.Path)
+			switch request.Method {
+			case http.MethodGet:
+				fmt.Fprint(writer, `{"id": 12, "version": 3, "title": "my title", "description": "my description"}`)
+			case http.MethodPut:
+				must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+				fmt.Fprint(writer, `{"id": 12, "version": 4}`)
+			}
+		})
+		err := connector.UpdateProposalTarget(12, gitdomain.NewLocalBranchName("new-target"))
+		must.NoError(t, err)
+		want := map[string]any{
+			"description": "my description",
+			"title":       "my title",
+			"toRef": map[string]any{
+				"id": "refs/heads/new-target",
+				"repository": map[string]any{
+					"project": map[string]any{
+						"key": "proj",
+					},
+					"slug": "repo",
+				},
+			},
+			"version": 3.0,
+		}
+		must.Eq(t, want, haveBody)
+	})
+
+	t.Run("CloseProposal", func(t *testing.T) {
+		t.Parallel()
+		haveVersion := ""
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			switch request.Method {
+			case http.MethodGet:
+				must.EqOp(t, "/rest/api/1.0/projects/proj/repos/repo/pull-requests/12", request.URL.Path)
+				fmt.Fprint(writer, `{"id": 12, "version": 3}`)
+			case http.MethodPost:
+				must.EqOp(t, "/rest/api/1.0/projects/proj/repos/repo/pull-requests/12/decline", request.URL.Path)
+				haveVersion = request.URL.Query().Get("version")
+				fmt.Fprint(writer, `{"id": 12, "version": 4, "state": "DECLINED"}`)
+			}
+		})
+		err := connector.CloseProposal(12)
+		must.NoError(t, err)
+		must.EqOp(t, "3", haveVersion)
+	})
+}
+
+// newTestConnector provides a Bitbucket Data Center connector that talks to an API stand-in served by the given handler.
+func newTestConnector(t *testing.T, handler http.HandlerFunc) *bitbucketdatacenter.Connector {
+	t.Helper()
+	return hostingapi.NewConnector(t, handler, func(url string) (*bitbucketdatacenter.Connector, error) {
+		connector, err := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+			APIToken:        "secret",
+			HostingPlatform: configdomain.HostingPlatformBitbucketDatacenter,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("ssh://git@bitbucket.example.com:7999/proj/repo.git"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		connector.BaseURL = url
+		return connector, nil
+	})
+}
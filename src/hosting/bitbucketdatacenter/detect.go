@@ -0,0 +1,12 @@
+package bitbucketdatacenter
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+)
+
+// Detect indicates whether the current repository is hosted on a Bitbucket Data Center server.
+// Data Center installations run on custom domains, so this requires the hosting platform to be configured.
+func Detect(originURL *giturl.Parts, hostingPlatform configdomain.HostingPlatform) bool {
+	return originURL != nil && hostingPlatform == configdomain.HostingPlatformBitbucketDatacenter
+}
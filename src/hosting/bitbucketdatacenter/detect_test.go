@@ -0,0 +1,32 @@
+package bitbucketdatacenter_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/bitbucketdatacenter"
+	"github.com/shoenig/test/must"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hosted service type provided manually", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, bitbucketdatacenter.Detect(giturl.Parse("ssh://git@bitbucket.example.com:7999/proj/repo.git"), configdomain.HostingPlatformBitbucketDatacenter))
+	})
+	t.Run("Bitbucket Cloud", func(t *testing.T) {
+		t.Parallel()
+		must.False(t, bitbucketdatacenter.Detect(giturl.Parse("username@bitbucket.org:git-town/docs.git"), configdomain.HostingPlatformNone))
+	})
+	t.Run("custom domain without configured hosting platform", func(t *testing.T) {
+		t.Parallel()
+		must.False(t, bitbucketdatacenter.Detect(giturl.Parse("ssh://git@bitbucket.example.com:7999/proj/repo.git"), configdomain.HostingPlatformNone))
+	})
+	t.Run("no origin remote", func(t *testing.T) {
+		t.Parallel()
+		var originURL *giturl.Parts
+		must.False(t, bitbucketdatacenter.Detect(originURL, configdomain.HostingPlatformBitbucketDatacenter))
+	})
+}
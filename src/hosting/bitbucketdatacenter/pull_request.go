@@ -0,0 +1,84 @@
+package bitbucketdatacenter
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+)
+
+// pullRequest contains the data of a pull request returned by the Bitbucket Data Center API.
+type pullRequest struct {
+	Description string `json:"description"`
+	ID          int    `json:"id"`
+	Links       links  `json:"links"`
+	Title       string `json:"title"`
+	ToRef       ref    `json:"toRef"`
+	Version     int    `json:"version"`
+}
+
+// proposal provides the standardized proposal data of this pull request.
+func (self pullRequest) proposal() hostingdomain.Proposal {
+	url := ""
+	if len(self.Links.Self) > 0 {
+		url = self.Links.Self[0].Href
+	}
+	return hostingdomain.Proposal{
+		Body:         self.Description,
+		MergeWithAPI: true,
+		Number:       self.ID,
+		Target:       gitdomain.NewLocalBranchName(self.ToRef.DisplayID),
+		Title:        self.Title,
+		URL:          url,
+	}
+}
+
+// pullRequestsPage contains a page of pull requests returned by the Bitbucket Data Center API.
+type pullRequestsPage struct {
+	Values []pullRequest `json:"values"`
+}
+
+// ref describes the source or target branch of a pull request.
+type ref struct {
+	DisplayID  string      `json:"displayId,omitempty"`
+	ID         string      `json:"id"`
+	Repository *repository `json:"repository,omitempty"`
+}
+
+type repository struct {
+	Project project `json:"project"`
+	Slug    string  `json:"slug"`
+}
+
+type project struct {
+	Key string `json:"key"`
+}
+
+type links struct {
+	Self []link `json:"self"`
+}
+
+type link struct {
+	Href string `json:"href"`
+}
+
+// errorResponse contains the error details that the Bitbucket Data Center API returns for failed requests.
+type errorResponse struct {
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// parseErrorMessage provides the error message contained in the given response body of a failed API request.
+func parseErrorMessage(responseData []byte) string {
+	var response errorResponse
+	if err := json.Unmarshal(responseData, &response); err == nil && len(response.Errors) > 0 {
+		messages := make([]string, len(response.Errors))
+		for e, apiError := range response.Errors {
+			messages[e] = apiError.Message
+		}
+		return strings.Join(messages, ", ")
+	}
+	return strings.TrimSpace(string(responseData))
+}
@@ -3,7 +3,10 @@ package hosting
 import (
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/azuredevops"
 	"github.com/git-town/git-town/v12/src/hosting/bitbucket"
+	"github.com/git-town/git-town/v12/src/hosting/bitbucketdatacenter"
+	"github.com/git-town/git-town/v12/src/hosting/forgejo"
 	"github.com/git-town/git-town/v12/src/hosting/gitea"
 	"github.com/git-town/git-town/v12/src/hosting/github"
 	"github.com/git-town/git-town/v12/src/hosting/gitlab"
@@ -11,8 +14,14 @@ import (
 
 func Detect(originURL *giturl.Parts, hostingPlatform configdomain.HostingPlatform) configdomain.HostingPlatform {
 	switch {
+	case azuredevops.Detect(originURL, hostingPlatform):
+		return configdomain.HostingPlatformAzureDevOps
+	case bitbucketdatacenter.Detect(originURL, hostingPlatform):
+		return configdomain.HostingPlatformBitbucketDatacenter
 	case bitbucket.Detect(originURL, hostingPlatform):
 		return configdomain.HostingPlatformBitbucket
+	case forgejo.Detect(originURL, hostingPlatform):
+		return configdomain.HostingPlatformForgejo
 	case gitea.Detect(originURL, hostingPlatform):
 		return configdomain.HostingPlatformGitea
 	case github.Detect(originURL, hostingPlatform):
@@ -0,0 +1,37 @@
+package forgejo
+
+import (
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/gitea"
+)
+
+// NewConnector provides a connector for repositories hosted on Forgejo.
+// Forgejo is a fork of Gitea that keeps the Gitea API, so this talks to it using the Gitea connector.
+func NewConnector(args NewConnectorArgs) (*gitea.Connector, error) {
+	return gitea.NewConnector(giteaConnectorArgs(args))
+}
+
+// NewConnectorWithBaseURL provides a connector that talks to the Forgejo server at the given URL.
+// Tests use this to talk to a stand-in server.
+func NewConnectorWithBaseURL(args NewConnectorArgs, baseURL string) *gitea.Connector {
+	return gitea.NewConnectorWithBaseURL(giteaConnectorArgs(args), baseURL)
+}
+
+type NewConnectorArgs struct {
+	APIToken        configdomain.ForgejoToken
+	HostingPlatform configdomain.HostingPlatform
+	Log             print.Logger
+	OriginURL       *giturl.Parts
+}
+
+// giteaConnectorArgs provides the arguments for the Gitea connector that talks to Forgejo.
+func giteaConnectorArgs(args NewConnectorArgs) gitea.NewConnectorArgs {
+	return gitea.NewConnectorArgs{
+		APIToken:        configdomain.GiteaToken(args.APIToken),
+		HostingPlatform: args.HostingPlatform,
+		Log:             args.Log,
+		OriginURL:       args.OriginURL,
+	}
+}
@@ -0,0 +1,94 @@
+package forgejo_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/forgejo"
+	"github.com/git-town/git-town/v12/src/hosting/gitea"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/test/hostingapi"
+	"github.com/shoenig/test/must"
+)
+
+func TestForgejoConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewConnector", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Codeberg", func(t *testing.T) {
+			t.Parallel()
+			have := newTestConnector(t, forgejo.NewConnectorArgs{
+				APIToken:        "apiToken",
+				HostingPlatform: configdomain.HostingPlatformNone,
+				Log:             print.Logger{},
+				OriginURL:       giturl.Parse("git@codeberg.org:git-town/docs.git"),
+			})
+			wantConfig := hostingdomain.Config{
+				Hostname:     "codeberg.org",
+				Organization: "git-town",
+				Repository:   "docs",
+			}
+			must.EqOp(t, wantConfig, have.Config)
+			must.EqOp(t, configdomain.GiteaToken("apiToken"), have.APIToken)
+		})
+
+		t.Run("self-hosted", func(t *testing.T) {
+			t.Parallel()
+			have := newTestConnector(t, forgejo.NewConnectorArgs{
+				APIToken:        "",
+				HostingPlatform: configdomain.HostingPlatformForgejo,
+				Log:             print.Logger{},
+				OriginURL:       giturl.Parse("https://forgejo.example.com/git-town/docs.git"),
+			})
+			wantConfig := hostingdomain.Config{
+				Hostname:     "forgejo.example.com",
+				Organization: "git-town",
+				Repository:   "docs",
+			}
+			must.EqOp(t, wantConfig, have.Config)
+		})
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, forgejo.NewConnectorArgs{
+			APIToken:        "",
+			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("git@codeberg.org:git-town/docs.git"),
+		})
+		have, err := connector.NewProposalURL("feature", gitdomain.NewLocalBranchName("parent"))
+		must.NoError(t, err)
+		must.EqOp(t, "https://codeberg.org/git-town/docs/compare/parent...feature", have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, forgejo.NewConnectorArgs{
+			APIToken:        "",
+			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("git@codeberg.org:git-town/docs.git"),
+		})
+		must.EqOp(t, "https://codeberg.org/git-town/docs", connector.RepositoryURL())
+	})
+}
+
+// newTestConnector provides a Forgejo connector that talks to a Forgejo stand-in server.
+func newTestConnector(t *testing.T, args forgejo.NewConnectorArgs) *gitea.Connector {
+	t.Helper()
+	versionHandler := func(writer http.ResponseWriter, request *http.Request) {
+		must.EqOp(t, "/api/v1/version", request.URL.Path)
+		fmt.Fprint(writer, `{"version": "7.0.0+gitea-1.22.0"}`)
+	}
+	return hostingapi.NewConnector(t, versionHandler, func(url string) (*gitea.Connector, error) {
+		return forgejo.NewConnectorWithBaseURL(args, url), nil
+	})
+}
@@ -0,0 +1,11 @@
+package forgejo
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+)
+
+// Detect indicates whether the current repository is hosted on a Forgejo server.
+func Detect(originURL *giturl.Parts, hostingPlatform configdomain.HostingPlatform) bool {
+	return originURL != nil && (originURL.Host == "codeberg.org" || hostingPlatform == configdomain.HostingPlatformForgejo)
+}
@@ -0,0 +1,35 @@
+package forgejo_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/forgejo"
+	"github.com/shoenig/test/must"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Codeberg", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, forgejo.Detect(giturl.Parse("git@codeberg.org:git-town/docs.git"), configdomain.HostingPlatformNone))
+	})
+
+	t.Run("hosted service type provided manually", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, forgejo.Detect(giturl.Parse("git@custom-url.com:git-town/docs.git"), configdomain.HostingPlatformForgejo))
+	})
+
+	t.Run("repo is hosted by another hosting platform", func(t *testing.T) {
+		t.Parallel()
+		must.False(t, forgejo.Detect(giturl.Parse("git@gitea.com:git-town/git-town.git"), configdomain.HostingPlatformNone))
+	})
+
+	t.Run("no origin remote", func(t *testing.T) {
+		t.Parallel()
+		var originURL *giturl.Parts
+		must.False(t, forgejo.Detect(originURL, configdomain.HostingPlatformNone))
+	})
+}
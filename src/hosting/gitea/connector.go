@@ -24,10 +24,66 @@ type Connector struct {
 	log      print.Logger
 }
 
+func (self *Connector) CloseProposal(number int) error {
+	self.log.Start(messages.HostingGiteaClosePRViaAPI, number)
+	// The Gitea API overwrites the body of the pull request with the body sent in the edit request,
+	// so this sends the current body along to preserve it.
+	pullRequest, _, err := self.client.GetPullRequest(self.Organization, self.Repository, int64(number))
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	closed := gitea.StateClosed
+	_, _, err = self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		Body:  pullRequest.Body,
+		State: &closed,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGiteaCreatingPRViaAPI, branch)
+	if draft {
+		// Gitea marks pull requests whose title starts with this prefix as work in progress
+		title = "WIP: " + title
+	}
+	pullRequest, _, err := self.client.CreatePullRequest(self.Organization, self.Repository, gitea.CreatePullRequestOption{
+		Head:  branch.String(),
+		Base:  target.String(),
+		Title: title,
+		Body:  body,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //nolint:exhaustruct
+	}
+	self.log.Success()
+	return hostingdomain.Proposal{
+		Body:         pullRequest.Body,
+		MergeWithAPI: pullRequest.Mergeable,
+		Number:       int(pullRequest.Index),
+		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
+		Title:        pullRequest.Title,
+		URL:          pullRequest.HTMLURL,
+	}, nil
+}
+
 func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
+// FastForwardProposal rebase-merges the proposal with the given number
+// because the Gitea SDK doesn't provide a fast-forward-only merge style.
+// This isn't a true fast-forward: the target branch receives rebased copies of the proposal's commits.
+func (self *Connector) FastForwardProposal(number int) error {
+	return self.mergePullRequest(number, "", gitea.MergeStyleRebase)
+}
+
 func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
 	openPullRequests, _, err := self.client.ListRepoPullRequests(self.Organization, self.Repository, gitea.ListPullRequestsOptions{
 		ListOptions: gitea.ListOptions{
@@ -47,13 +103,19 @@ func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*
 	}
 	pullRequest := pullRequests[0]
 	return &hostingdomain.Proposal{
+		Body:         pullRequest.Body,
 		MergeWithAPI: pullRequest.Mergeable,
 		Number:       int(pullRequest.Index),
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
 		Title:        pullRequest.Title,
+		URL:          pullRequest.HTMLURL,
 	}, nil
 }
 
+func (self *Connector) MergeCommitProposal(number int, message string) error {
+	return self.mergePullRequest(number, message, gitea.MergeStyleMerge)
+}
+
 func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
 	toCompare := parentBranch.String() + "..." + branch.String()
 	return fmt.Sprintf("%s/compare/%s", self.RepositoryURL(), url.PathEscape(toCompare)), nil
@@ -64,12 +126,51 @@ func (self *Connector) RepositoryURL() string {
 }
 
 func (self *Connector) SquashMergeProposal(number int, message string) error {
+	return self.mergePullRequest(number, message, gitea.MergeStyleSquash)
+}
+
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingGiteaUpdatePRBodyViaAPI, number)
+	_, _, err := self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		Body: body,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingGiteaUpdatePRViaAPI, number, target)
+	// The Gitea API overwrites the body of the pull request with the body sent in the edit request,
+	// so this sends the current body along to preserve it.
+	pullRequest, _, err := self.client.GetPullRequest(self.Organization, self.Repository, int64(number))
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	_, _, err = self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		Base: target.String(),
+		Body: pullRequest.Body,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// mergePullRequest merges the pull request with the given number using the given merge style.
+func (self *Connector) mergePullRequest(number int, message string, style gitea.MergeStyle) error {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
 	commitMessageParts := commitmessage.Split(message)
 	_, _, err := self.client.MergePullRequest(self.Organization, self.Repository, int64(number), gitea.MergePullRequestOption{
-		Style:   gitea.MergeStyleSquash,
+		Style:   style,
 		Title:   commitMessageParts.Title,
 		Message: commitMessageParts.Body,
 	})
@@ -80,24 +181,12 @@ func (self *Connector) SquashMergeProposal(number int, message string) error {
 	return err
 }
 
-func (self *Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
-	// TODO: update the client and uncomment
-	// if self.log != nil {
-	// 	self.log(message.HostingGiteaUpdateBasebranchViaAPI, number, target)
-	// }
-	// _, err := self.client.EditPullRequest(self.owner, self.repository, int64(number), gitea.EditPullRequestOption{
-	// 	Base: newBaseName,
-	// })
-	// return err
-	return errors.New(messages.HostingGiteaNotImplemented)
-}
-
 func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string, branch, target gitdomain.LocalBranchName) []*gitea.PullRequest {
 	result := []*gitea.PullRequest{}
 	headName := organization + "/" + branch.String()
 	for p := range pullRequests {
 		pullRequest := pullRequests[p]
-		if pullRequest.Head.Name == headName && pullRequest.Base.Name == target.String() {
+		if pullRequest.Head.Name == headName && (target.IsEmpty() || pullRequest.Base.Name == target.String()) {
 			result = append(result, pullRequest)
 		}
 	}
@@ -107,9 +196,15 @@ func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string,
 // NewGiteaConfig provides Gitea configuration data if the current repo is hosted on Gitea,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) (*Connector, error) {
+	return NewConnectorWithBaseURL(args, "https://"+args.OriginURL.Host), nil
+}
+
+// NewConnectorWithBaseURL provides a Gitea connector that talks to the Gitea server at the given URL.
+// Tests use this to talk to a stand-in server.
+func NewConnectorWithBaseURL(args NewConnectorArgs, baseURL string) *Connector {
 	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
 	httpClient := oauth2.NewClient(context.Background(), tokenSource)
-	giteaClient := gitea.NewClientWithHTTP("https://"+args.OriginURL.Host, httpClient)
+	giteaClient := gitea.NewClientWithHTTP(baseURL, httpClient)
 	return &Connector{
 		APIToken: args.APIToken,
 		Config: hostingdomain.Config{
@@ -119,7 +214,7 @@ func NewConnector(args NewConnectorArgs) (*Connector, error) {
 		},
 		client: giteaClient,
 		log:    args.Log,
-	}, nil
+	}
 }
 
 type NewConnectorArgs struct {
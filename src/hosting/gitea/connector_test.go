@@ -1,12 +1,19 @@
 package gitea_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"testing"
 
 	giteasdk "code.gitea.io/sdk/gitea"
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/git/giturl"
 	"github.com/git-town/git-town/v12/src/hosting/gitea"
 	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/test/hostingapi"
 	"github.com/shoenig/test/must"
 )
 
@@ -64,6 +71,42 @@ func TestFilterGiteaPullRequests(t *testing.T) {
 	must.Eq(t, want, have)
 }
 
+func TestFilterGiteaPullRequestsWithoutTarget(t *testing.T) {
+	t.Parallel()
+	give := []*giteasdk.PullRequest{
+		// matching branch
+		{
+			Head: &giteasdk.PRBranchInfo{
+				Name: "organization/branch",
+			},
+			Base: &giteasdk.PRBranchInfo{
+				Name: "target",
+			},
+		},
+		// branch with different name
+		{
+			Head: &giteasdk.PRBranchInfo{
+				Name: "organization/other",
+			},
+			Base: &giteasdk.PRBranchInfo{
+				Name: "target",
+			},
+		},
+	}
+	want := []*giteasdk.PullRequest{
+		{
+			Head: &giteasdk.PRBranchInfo{
+				Name: "organization/branch",
+			},
+			Base: &giteasdk.PRBranchInfo{
+				Name: "target",
+			},
+		},
+	}
+	have := gitea.FilterPullRequests(give, "organization", gitdomain.NewLocalBranchName("branch"), gitdomain.EmptyLocalBranchName())
+	must.Eq(t, want, have)
+}
+
 //nolint:paralleltest  // mocks HTTP
 func TestGitea(t *testing.T) {
 	t.Run("DefaultProposalMessage", func(t *testing.T) {
@@ -168,3 +211,115 @@ func TestNewGiteaConnector(t *testing.T) {
 	// 	must.NoError(t, err)
 	// })
 }
+
+func TestGiteaUpdateProposalTarget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("preserves the body of the pull request", func(t *testing.T) {
+		t.Parallel()
+		var haveMethods []string
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, "/api/v1/repos/git-town/docs/pulls/12", request.URL.Path)
+			haveMethods = append(haveMethods, request.Method)
+			switch request.Method {
+			case http.MethodGet:
+				fmt.Fprint(writer, `{"number": 12, "title": "my title", "body": "my body", "base": {"ref": "old-target"}}`)
+			case http.MethodPatch:
+				must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+				fmt.Fprint(writer, `{"number": 12, "title": "my title", "body": "my body", "base": {"ref": "new-target"}}`)
+			}
+		})
+		err := connector.UpdateProposalTarget(12, gitdomain.NewLocalBranchName("new-target"))
+		must.NoError(t, err)
+		must.Eq(t, []string{http.MethodGet, http.MethodPatch}, haveMethods)
+		must.EqOp(t, "new-target", haveBody["base"].(string))
+		must.EqOp(t, "my body", haveBody["body"].(string))
+	})
+
+	t.Run("pull request cannot be loaded", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodGet, request.Method)
+			writer.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(writer, `{"message": "pull request does not exist"}`)
+		})
+		err := connector.UpdateProposalTarget(12, gitdomain.NewLocalBranchName("new-target"))
+		must.Error(t, err)
+	})
+}
+
+func TestGiteaCloseProposal(t *testing.T) {
+	t.Parallel()
+	var haveBody map[string]any
+	connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+		must.EqOp(t, "/api/v1/repos/git-town/docs/pulls/12", request.URL.Path)
+		switch request.Method {
+		case http.MethodGet:
+			fmt.Fprint(writer, `{"number": 12, "title": "my title", "body": "my body", "state": "open"}`)
+		case http.MethodPatch:
+			must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+			fmt.Fprint(writer, `{"number": 12, "title": "my title", "body": "my body", "state": "closed"}`)
+		}
+	})
+	err := connector.CloseProposal(12)
+	must.NoError(t, err)
+	must.EqOp(t, "closed", haveBody["state"].(string))
+	must.EqOp(t, "my body", haveBody["body"].(string))
+}
+
+func TestGiteaCreateProposal(t *testing.T) {
+	t.Parallel()
+	var haveBody map[string]any
+	connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+		must.EqOp(t, http.MethodPost, request.Method)
+		must.EqOp(t, "/api/v1/repos/git-town/docs/pulls", request.URL.Path)
+		must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+		writer.WriteHeader(http.StatusCreated)
+		fmt.Fprint(writer, `{
+			"number": 12,
+			"title": "WIP: my title",
+			"body": "my body",
+			"mergeable": true,
+			"base": {"ref": "parent"},
+			"html_url": "https://gitea.com/git-town/docs/pulls/12"
+		}`)
+	})
+	have, err := connector.CreateProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"), "my title", "my body", true)
+	must.NoError(t, err)
+	must.EqOp(t, "feature", haveBody["head"].(string))
+	must.EqOp(t, "parent", haveBody["base"].(string))
+	must.EqOp(t, "WIP: my title", haveBody["title"].(string))
+	must.EqOp(t, "my body", haveBody["body"].(string))
+	want := hostingdomain.Proposal{
+		Body:         "my body",
+		MergeWithAPI: true,
+		Number:       12,
+		Target:       gitdomain.NewLocalBranchName("parent"),
+		Title:        "WIP: my title",
+		URL:          "https://gitea.com/git-town/docs/pulls/12",
+	}
+	must.EqOp(t, want, have)
+}
+
+// newTestConnector provides a Gitea connector that talks to a Gitea stand-in server.
+// The stand-in answers version requests itself and forwards all other requests to the given handler.
+func newTestConnector(t *testing.T, handler http.HandlerFunc) *gitea.Connector {
+	t.Helper()
+	// the Gitea SDK queries the server version when creating the client
+	versionHandler := func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/api/v1/version" {
+			fmt.Fprint(writer, `{"version": "1.21.0"}`)
+			return
+		}
+		handler(writer, request)
+	}
+	return hostingapi.NewConnector(t, versionHandler, func(url string) (*gitea.Connector, error) {
+		return gitea.NewConnectorWithBaseURL(gitea.NewConnectorArgs{
+			APIToken:        "secret",
+			HostingPlatform: configdomain.HostingPlatformGitea,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("git@gitea.com:git-town/docs.git"),
+		}, url), nil
+	})
+}
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strings"
 
 	"github.com/git-town/git-town/v12/src/cli/print"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/envconfig"
 	"github.com/git-town/git-town/v12/src/git/commitmessage"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/git/giturl"
@@ -18,6 +20,9 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// defaultAPIURL is the URL of the public GitHub API.
+const defaultAPIURL = "https://api.github.com"
+
 // Connector provides standardized connectivity for the given repository (github.com/owner/repo)
 // via the GitHub API.
 type Connector struct {
@@ -28,10 +33,47 @@ type Connector struct {
 	log        print.Logger
 }
 
+func (self *Connector) CloseProposal(number int) error {
+	self.log.Start(messages.HostingGithubClosePRViaAPI, number)
+	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+		State: github.String("closed"),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGithubCreatingPRViaAPI, branch)
+	pullRequest, _, err := self.client.PullRequests.Create(context.Background(), self.Organization, self.Repository, &github.NewPullRequest{
+		Title: github.String(title),
+		Head:  github.String(branch.String()),
+		Base:  github.String(target.String()),
+		Body:  github.String(body),
+		Draft: github.Bool(draft),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //nolint:exhaustruct
+	}
+	self.log.Success()
+	return parsePullRequest(pullRequest), nil
+}
+
 func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
+// FastForwardProposal rebase-merges the proposal with the given number
+// because GitHub doesn't support fast-forwarding pull requests.
+// This doesn't fast-forward the target branch: GitHub always creates new commits with new SHAs on it.
+func (self *Connector) FastForwardProposal(number int) error {
+	return self.mergeProposal(number, "", "rebase")
+}
+
 func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
 	pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
 		Head:  self.Organization + ":" + branch.String(),
@@ -51,6 +93,10 @@ func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*
 	return &proposal, nil
 }
 
+func (self *Connector) MergeCommitProposal(number int, message string) error {
+	return self.mergeProposal(number, message, "merge")
+}
+
 func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
 	toCompare := branch.String()
 	if parentBranch != self.MainBranch {
@@ -63,18 +109,21 @@ func (self *Connector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
 }
 
-func (self *Connector) SquashMergeProposal(number int, message string) (err error) {
-	if number <= 0 {
-		return errors.New(messages.ProposalNoNumberGiven)
-	}
-	self.log.Start(messages.HostingGithubMergingViaAPI, number)
-	commitMessageParts := commitmessage.Split(message)
-	_, _, err = self.client.PullRequests.Merge(context.Background(), self.Organization, self.Repository, number, commitMessageParts.Body, &github.PullRequestOptions{
-		MergeMethod: "squash",
-		CommitTitle: commitMessageParts.Title,
+func (self *Connector) SquashMergeProposal(number int, message string) error {
+	return self.mergeProposal(number, message, "squash")
+}
+
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingGithubUpdatePRBodyViaAPI, number)
+	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+		Body: github.String(body),
 	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
 	self.log.Success()
-	return err
+	return nil
 }
 
 func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
@@ -93,6 +142,21 @@ func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBr
 	return nil
 }
 
+// mergeProposal merges the proposal with the given number using the given GitHub merge method.
+func (self *Connector) mergeProposal(number int, message, mergeMethod string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGithubMergingViaAPI, number)
+	commitMessageParts := commitmessage.Split(message)
+	_, _, err := self.client.PullRequests.Merge(context.Background(), self.Organization, self.Repository, number, commitMessageParts.Body, &github.PullRequestOptions{
+		MergeMethod: mergeMethod,
+		CommitTitle: commitMessageParts.Title,
+	})
+	self.log.Success()
+	return err
+}
+
 // getGitHubApiToken returns the GitHub API token to use.
 // It first checks the GITHUB_TOKEN environment variable.
 // If that is not set, it checks the GITHUB_AUTH_TOKEN environment variable.
@@ -111,9 +175,27 @@ func GetAPIToken(gitConfigToken configdomain.GitHubToken) configdomain.GitHubTok
 
 // NewConnector provides a fully configured GithubConnector instance
 // if the current repo is hosted on Github, otherwise nil.
+// Tests can stub the GitHub API through the GIT_TOWN_GITHUB_API_URL environment variable.
 func NewConnector(args NewConnectorArgs) (*Connector, error) {
+	apiURL := envconfig.GitHubAPIURLOverride()
+	if apiURL == "" {
+		apiURL = defaultAPIURL
+	}
+	return NewConnectorWithBaseURL(args, apiURL)
+}
+
+// NewConnectorWithBaseURL provides a GitHub connector that talks to the GitHub API at the given URL.
+// Tests use this to talk to a stand-in server.
+func NewConnectorWithBaseURL(args NewConnectorArgs, baseURL string) (*Connector, error) {
 	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
 	httpClient := oauth2.NewClient(context.Background(), tokenSource)
+	client := github.NewClient(httpClient)
+	// the GitHub client requires a trailing slash in its base URL
+	parsedURL, err := url.Parse(strings.TrimSuffix(baseURL, "/") + "/")
+	if err != nil {
+		return nil, err
+	}
+	client.BaseURL = parsedURL
 	return &Connector{
 		APIToken: args.APIToken,
 		Config: hostingdomain.Config{
@@ -122,7 +204,7 @@ func NewConnector(args NewConnectorArgs) (*Connector, error) {
 			Repository:   args.OriginURL.Repo,
 		},
 		MainBranch: args.MainBranch,
-		client:     github.NewClient(httpClient),
+		client:     client,
 		log:        args.Log,
 	}, nil
 }
@@ -138,9 +220,11 @@ type NewConnectorArgs struct {
 // parsePullRequest extracts standardized proposal data from the given GitHub pull-request.
 func parsePullRequest(pullRequest *github.PullRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         pullRequest.GetBody(),
 		Number:       pullRequest.GetNumber(),
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.GetRef()),
 		Title:        pullRequest.GetTitle(),
 		MergeWithAPI: pullRequest.GetMergeableState() == "clean",
+		URL:          pullRequest.GetHTMLURL(),
 	}
 }
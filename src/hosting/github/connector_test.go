@@ -1,6 +1,9 @@
 package github_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/git-town/git-town/v12/src/cli/print"
@@ -9,6 +12,7 @@ import (
 	"github.com/git-town/git-town/v12/src/git/giturl"
 	"github.com/git-town/git-town/v12/src/hosting/github"
 	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/test/hostingapi"
 	"github.com/shoenig/test/must"
 )
 
@@ -83,6 +87,64 @@ func TestConnector(t *testing.T) {
 	})
 }
 
+func TestConnectorAPI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("CloseProposal", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodPatch, request.Method)
+			must.EqOp(t, "/repos/git-town/docs/pulls/12", request.URL.Path)
+			must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+			fmt.Fprint(writer, `{"number": 12, "state": "closed"}`)
+		})
+		err := connector.CloseProposal(12)
+		must.NoError(t, err)
+		want := map[string]any{
+			"state": "closed",
+		}
+		must.Eq(t, want, haveBody)
+	})
+
+	t.Run("CreateProposal", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodPost, request.Method)
+			must.EqOp(t, "/repos/git-town/docs/pulls", request.URL.Path)
+			must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+			writer.WriteHeader(http.StatusCreated)
+			fmt.Fprint(writer, `{
+				"number": 12,
+				"title": "my title",
+				"body": "my body",
+				"base": {"ref": "parent"},
+				"html_url": "https://github.com/git-town/docs/pull/12"
+			}`)
+		})
+		have, err := connector.CreateProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"), "my title", "my body", true)
+		must.NoError(t, err)
+		wantBody := map[string]any{
+			"base":  "parent",
+			"body":  "my body",
+			"draft": true,
+			"head":  "feature",
+			"title": "my title",
+		}
+		must.Eq(t, wantBody, haveBody)
+		want := hostingdomain.Proposal{
+			Body:         "my body",
+			MergeWithAPI: false,
+			Number:       12,
+			Target:       gitdomain.NewLocalBranchName("parent"),
+			Title:        "my title",
+			URL:          "https://github.com/git-town/docs/pull/12",
+		}
+		must.EqOp(t, want, have)
+	})
+}
+
 func TestNewConnector(t *testing.T) {
 	t.Parallel()
 
@@ -122,3 +184,17 @@ func TestNewConnector(t *testing.T) {
 		must.EqOp(t, wantConfig, have.Config)
 	})
 }
+
+// newTestConnector provides a GitHub connector that talks to an API stand-in served by the given handler.
+func newTestConnector(t *testing.T, handler http.HandlerFunc) *github.Connector {
+	t.Helper()
+	return hostingapi.NewConnector(t, handler, func(url string) (*github.Connector, error) {
+		return github.NewConnectorWithBaseURL(github.NewConnectorArgs{
+			APIToken:        "secret",
+			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
+			MainBranch:      gitdomain.NewLocalBranchName("main"),
+			OriginURL:       giturl.Parse("git@github.com:git-town/docs.git"),
+		}, url)
+	})
+}
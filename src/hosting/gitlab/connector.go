@@ -22,11 +22,46 @@ type Connector struct {
 	log print.Logger
 }
 
+func (self *Connector) CloseProposal(number int) error {
+	self.log.Start(messages.HostingGitlabCloseMRViaAPI, number)
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
+		StateEvent: gitlab.Ptr("close"),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGitlabCreatingMRViaAPI, branch)
+	if draft {
+		// GitLab marks merge requests whose title starts with this prefix as drafts
+		title = "Draft: " + title
+	}
+	mergeRequest, _, err := self.client.MergeRequests.CreateMergeRequest(self.projectPath(), &gitlab.CreateMergeRequestOptions{
+		Title:        gitlab.Ptr(title),
+		Description:  gitlab.Ptr(body),
+		SourceBranch: gitlab.Ptr(branch.String()),
+		TargetBranch: gitlab.Ptr(target.String()),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err //nolint:exhaustruct
+	}
+	self.log.Success()
+	return parseMergeRequest(mergeRequest), nil
+}
+
 func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
 	opts := &gitlab.ListProjectMergeRequestsOptions{
 		State:        gitlab.Ptr("opened"),
 		SourceBranch: gitlab.Ptr(branch.String()),
-		TargetBranch: gitlab.Ptr(target.String()),
+	}
+	if !target.IsEmpty() {
+		opts.TargetBranch = gitlab.Ptr(target.String())
 	}
 	mergeRequests, _, err := self.client.MergeRequests.ListProjectMergeRequests(self.projectPath(), opts)
 	if err != nil {
@@ -42,18 +77,43 @@ func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*
 	return &proposal, nil
 }
 
-func (self *Connector) SquashMergeProposal(number int, message string) error {
-	if number <= 0 {
-		return errors.New(messages.ProposalNoNumberGiven)
+// FastForwardProposal merges the proposal with the given number without squashing its commits.
+// Whether GitLab fast-forwards the target branch depends on the merge method configured for the project.
+func (self *Connector) FastForwardProposal(number int) error {
+	return self.acceptMergeRequest(number, &gitlab.AcceptMergeRequestOptions{
+		Squash: gitlab.Ptr(false),
+		// the branch will be deleted by Git Town
+		ShouldRemoveSourceBranch: gitlab.Ptr(false),
+	})
+}
+
+func (self *Connector) MergeCommitProposal(number int, message string) error {
+	options := gitlab.AcceptMergeRequestOptions{
+		Squash: gitlab.Ptr(false),
+		// the branch will be deleted by Git Town
+		ShouldRemoveSourceBranch: gitlab.Ptr(false),
 	}
-	self.log.Start(messages.HostingGitlabMergingViaAPI, number)
+	if message != "" {
+		options.MergeCommitMessage = gitlab.Ptr(message)
+	}
+	return self.acceptMergeRequest(number, &options)
+}
+
+func (self *Connector) SquashMergeProposal(number int, message string) error {
 	// the GitLab API wants the full commit message in the body
-	_, _, err := self.client.MergeRequests.AcceptMergeRequest(self.projectPath(), number, &gitlab.AcceptMergeRequestOptions{
+	return self.acceptMergeRequest(number, &gitlab.AcceptMergeRequestOptions{
 		SquashCommitMessage: gitlab.Ptr(message),
 		Squash:              gitlab.Ptr(true),
 		// the branch will be deleted by Git Town
 		ShouldRemoveSourceBranch: gitlab.Ptr(false),
 	})
+}
+
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingGitlabUpdateMRBodyViaAPI, number)
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
+		Description: gitlab.Ptr(body),
+	})
 	if err != nil {
 		self.log.Failed(err)
 		return err
@@ -78,6 +138,13 @@ func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBr
 // NewGitlabConfig provides GitLab configuration data if the current repo is hosted on GitLab,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) (*Connector, error) {
+	return NewConnectorWithBaseURL(args, "")
+}
+
+// NewConnectorWithBaseURL provides a GitLab connector that talks to the GitLab server at the given URL,
+// or the server that hosts the origin remote if the given URL is empty.
+// Tests use this to talk to a stand-in server.
+func NewConnectorWithBaseURL(args NewConnectorArgs, baseURL string) (*Connector, error) {
 	gitlabConfig := Config{
 		APIToken: args.APIToken,
 		Config: hostingdomain.Config{
@@ -86,7 +153,10 @@ func NewConnector(args NewConnectorArgs) (*Connector, error) {
 			Repository:   args.OriginURL.Repo,
 		},
 	}
-	clientOptFunc := gitlab.WithBaseURL(gitlabConfig.baseURL())
+	if baseURL == "" {
+		baseURL = gitlabConfig.baseURL()
+	}
+	clientOptFunc := gitlab.WithBaseURL(baseURL)
 	httpClient := gitlab.WithHTTPClient(&http.Client{})
 	client, err := gitlab.NewOAuthClient(gitlabConfig.APIToken.String(), httpClient, clientOptFunc)
 	if err != nil {
@@ -107,11 +177,28 @@ type NewConnectorArgs struct {
 	OriginURL       *giturl.Parts
 }
 
+// acceptMergeRequest merges the merge request with the given number using the given options.
+func (self *Connector) acceptMergeRequest(number int, options *gitlab.AcceptMergeRequestOptions) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGitlabMergingViaAPI, number)
+	_, _, err := self.client.MergeRequests.AcceptMergeRequest(self.projectPath(), number, options)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
 func parseMergeRequest(mergeRequest *gitlab.MergeRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         mergeRequest.Description,
 		Number:       mergeRequest.IID,
 		Target:       gitdomain.NewLocalBranchName(mergeRequest.TargetBranch),
 		Title:        mergeRequest.Title,
 		MergeWithAPI: true,
+		URL:          mergeRequest.WebURL,
 	}
 }
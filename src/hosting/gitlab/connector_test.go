@@ -1,6 +1,9 @@
 package gitlab_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/git-town/git-town/v12/src/cli/print"
@@ -9,6 +12,7 @@ import (
 	"github.com/git-town/git-town/v12/src/git/giturl"
 	"github.com/git-town/git-town/v12/src/hosting/gitlab"
 	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/test/hostingapi"
 	"github.com/shoenig/test/must"
 )
 
@@ -26,10 +30,12 @@ func TestGitlabConnector(t *testing.T) {
 			APIToken: "",
 		}
 		give := hostingdomain.Proposal{
+			Body:         "",
 			Number:       1,
 			MergeWithAPI: true,
 			Target:       gitdomain.EmptyLocalBranchName(),
 			Title:        "my title",
+			URL:          "",
 		}
 		have := config.DefaultProposalMessage(give)
 		want := "my title (!1)"
@@ -79,6 +85,63 @@ func TestGitlabConnector(t *testing.T) {
 	})
 }
 
+func TestGitlabConnectorAPI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("CloseProposal", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodPut, request.Method)
+			must.EqOp(t, "/api/v4/projects/git-town%2Fdocs/merge_requests/12", request.URL.EscapedPath())
+			must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+			fmt.Fprint(writer, `{"iid": 12, "state": "closed"}`)
+		})
+		err := connector.CloseProposal(12)
+		must.NoError(t, err)
+		want := map[string]any{
+			"state_event": "close",
+		}
+		must.Eq(t, want, haveBody)
+	})
+
+	t.Run("CreateProposal", func(t *testing.T) {
+		t.Parallel()
+		var haveBody map[string]any
+		connector := newTestConnector(t, func(writer http.ResponseWriter, request *http.Request) {
+			must.EqOp(t, http.MethodPost, request.Method)
+			must.EqOp(t, "/api/v4/projects/git-town%2Fdocs/merge_requests", request.URL.EscapedPath())
+			must.NoError(t, json.NewDecoder(request.Body).Decode(&haveBody))
+			writer.WriteHeader(http.StatusCreated)
+			fmt.Fprint(writer, `{
+				"iid": 12,
+				"title": "Draft: my title",
+				"description": "my body",
+				"target_branch": "parent",
+				"web_url": "https://gitlab.com/git-town/docs/-/merge_requests/12"
+			}`)
+		})
+		have, err := connector.CreateProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"), "my title", "my body", true)
+		must.NoError(t, err)
+		wantBody := map[string]any{
+			"description":   "my body",
+			"source_branch": "feature",
+			"target_branch": "parent",
+			"title":         "Draft: my title",
+		}
+		must.Eq(t, wantBody, haveBody)
+		want := hostingdomain.Proposal{
+			Body:         "my body",
+			MergeWithAPI: true,
+			Number:       12,
+			Target:       gitdomain.NewLocalBranchName("parent"),
+			Title:        "Draft: my title",
+			URL:          "https://gitlab.com/git-town/docs/-/merge_requests/12",
+		}
+		must.EqOp(t, want, have)
+	})
+}
+
 func TestNewGitlabConnector(t *testing.T) {
 	t.Parallel()
 
@@ -122,3 +185,16 @@ func TestNewGitlabConnector(t *testing.T) {
 		must.EqOp(t, wantConfig, have.Config)
 	})
 }
+
+// newTestConnector provides a GitLab connector that talks to an API stand-in served by the given handler.
+func newTestConnector(t *testing.T, handler http.HandlerFunc) *gitlab.Connector {
+	t.Helper()
+	return hostingapi.NewConnector(t, handler, func(url string) (*gitlab.Connector, error) {
+		return gitlab.NewConnectorWithBaseURL(gitlab.NewConnectorArgs{
+			APIToken:        "secret",
+			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
+			OriginURL:       giturl.Parse("git@gitlab.com:git-town/docs.git"),
+		}, url)
+	})
+}
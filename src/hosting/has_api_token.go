@@ -0,0 +1,29 @@
+package hosting
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/hosting/github"
+)
+
+// HasAPIToken indicates whether an API token is configured for the code hosting platform of the given origin.
+func HasAPIToken(args NewConnectorArgs) bool {
+	switch Detect(args.OriginURL, args.HostingPlatform) {
+	case configdomain.HostingPlatformAzureDevOps:
+		return args.AzureDevOpsToken != ""
+	case configdomain.HostingPlatformBitbucket:
+		return args.BitbucketUsername != "" && args.BitbucketAppPassword != ""
+	case configdomain.HostingPlatformBitbucketDatacenter:
+		return args.BitbucketDatacenterToken != ""
+	case configdomain.HostingPlatformForgejo:
+		return args.ForgejoToken != ""
+	case configdomain.HostingPlatformGitea:
+		return args.GiteaToken != ""
+	case configdomain.HostingPlatformGitHub:
+		return github.GetAPIToken(args.GitHubToken) != ""
+	case configdomain.HostingPlatformGitLab:
+		return args.GitLabToken != ""
+	case configdomain.HostingPlatformNone:
+		return false
+	}
+	return false
+}
@@ -5,14 +5,32 @@ import "github.com/git-town/git-town/v12/src/git/gitdomain"
 // Connector describes the activities that Git Town can perform on code hosting platforms.
 // Individual implementations exist to talk to specific hosting platforms.
 type Connector interface {
+	// CloseProposal closes the proposal with the given number without merging it.
+	CloseProposal(number int) error
+
+	// CreateProposal creates a proposal to merge the given branch into the given target branch
+	// and provides the created proposal.
+	CreateProposal(branch, target gitdomain.LocalBranchName, title, body string, draft bool) (Proposal, error)
+
 	// DefaultProposalMessage provides the text that the form for creating new proposals
 	// on the respective hosting platform is prepopulated with.
 	DefaultProposalMessage(proposal Proposal) string
 
 	// FindProposal provides details about the proposal for the given branch into the given target branch.
+	// If the given target branch is empty, finds proposals into any target branch.
 	// Returns nil if no proposal exists.
 	FindProposal(branch, target gitdomain.LocalBranchName) (*Proposal, error)
 
+	// FastForwardProposal merges the proposal with the given number
+	// without creating a merge commit.
+	// Platforms that cannot fast-forward proposals rebase-merge them instead,
+	// which puts copies of the proposal's commits with new SHAs onto the target branch.
+	FastForwardProposal(number int) error
+
+	// MergeCommitProposal merges the proposal with the given number
+	// using a merge commit with the given message.
+	MergeCommitProposal(number int, message string) error
+
 	// SquashMergeProposal squash-merges the proposal with the given number
 	// using the given commit message.
 	SquashMergeProposal(number int, message string) error
@@ -24,6 +42,9 @@ type Connector interface {
 	// RepositoryURL provides the URL where the current repository can be found online.
 	RepositoryURL() string
 
+	// UpdateProposalBody replaces the body of the proposal with the given number.
+	UpdateProposalBody(number int, body string) error
+
 	// UpdateProposalTarget updates the target branch of the given proposal.
 	UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error
 }
@@ -5,6 +5,9 @@ import "github.com/git-town/git-town/v12/src/git/gitdomain"
 // Proposal contains information about a change request on a code hosting platform.
 // Alternative names are "pull request" or "merge request".
 type Proposal struct {
+	// the textual description of the proposal
+	Body string
+
 	// whether this proposal can be merged via the API
 	MergeWithAPI bool
 
@@ -16,4 +19,7 @@ type Proposal struct {
 
 	// textual title of the proposal
 	Title string
+
+	// the URL of the web page of this proposal
+	URL string
 }
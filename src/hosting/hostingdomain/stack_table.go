@@ -0,0 +1,61 @@
+package hostingdomain
+
+import (
+	"strings"
+)
+
+const (
+	// StackTableStart marks the beginning of the stack table that Git Town manages in proposal bodies.
+	StackTableStart = "<!-- git-town stack start -->"
+	// StackTableEnd marks the end of the stack table that Git Town manages in proposal bodies.
+	StackTableEnd = "<!-- git-town stack end -->"
+)
+
+// StackTableEntry describes a proposal listed in the stack table of a proposal body.
+type StackTableEntry struct {
+	Current bool   // whether this is the proposal whose body contains the table
+	Level   int    // how deep this proposal is nested in the stack, starting at 0
+	Text    string // the text that identifies the proposal
+}
+
+// StackTable provides the managed stack table for the given entries.
+// Stacks with less than two proposals don't need a table.
+func StackTable(entries []StackTableEntry) string {
+	if len(entries) < 2 {
+		return ""
+	}
+	result := strings.Builder{}
+	result.WriteString(StackTableStart + "\n")
+	result.WriteString("This proposal is part of a stack:\n\n")
+	for _, entry := range entries {
+		result.WriteString(strings.Repeat("  ", entry.Level) + "- " + entry.Text)
+		if entry.Current {
+			result.WriteString(" ← this proposal")
+		}
+		result.WriteString("\n")
+	}
+	result.WriteString(StackTableEnd)
+	return result.String()
+}
+
+// UpdateStackTable provides the given proposal body with its stack table replaced by the given table.
+// Appends the table if the body doesn't contain one yet and removes the existing table if the given table is empty.
+func UpdateStackTable(body, table string) string {
+	start := strings.Index(body, StackTableStart)
+	end := strings.Index(body, StackTableEnd)
+	if start == -1 || end < start {
+		if table == "" {
+			return body
+		}
+		if strings.TrimSpace(body) == "" {
+			return table
+		}
+		return strings.TrimRight(body, "\n") + "\n\n" + table
+	}
+	before := body[:start]
+	after := body[end+len(StackTableEnd):]
+	if table == "" {
+		return strings.TrimRight(before, "\n") + after
+	}
+	return before + table + after
+}
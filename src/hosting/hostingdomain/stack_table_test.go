@@ -0,0 +1,76 @@
+package hostingdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestStackTable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("StackTable", func(t *testing.T) {
+		t.Parallel()
+		t.Run("multiple proposals", func(t *testing.T) {
+			t.Parallel()
+			give := []hostingdomain.StackTableEntry{
+				{Current: false, Level: 0, Text: "alpha (#1)"},
+				{Current: true, Level: 1, Text: "beta (#2)"},
+				{Current: false, Level: 2, Text: "gamma (#3)"},
+			}
+			have := hostingdomain.StackTable(give)
+			want := `
+<!-- git-town stack start -->
+This proposal is part of a stack:
+
+- alpha (#1)
+  - beta (#2) ← this proposal
+    - gamma (#3)
+<!-- git-town stack end -->`[1:]
+			must.EqOp(t, want, have)
+		})
+		t.Run("single proposal", func(t *testing.T) {
+			t.Parallel()
+			give := []hostingdomain.StackTableEntry{
+				{Current: true, Level: 0, Text: "alpha (#1)"},
+			}
+			have := hostingdomain.StackTable(give)
+			must.EqOp(t, "", have)
+		})
+	})
+
+	t.Run("UpdateStackTable", func(t *testing.T) {
+		t.Parallel()
+		table := hostingdomain.StackTableStart + "\nnew table\n" + hostingdomain.StackTableEnd
+		t.Run("body without table", func(t *testing.T) {
+			t.Parallel()
+			have := hostingdomain.UpdateStackTable("description\n", table)
+			want := "description\n\n" + table
+			must.EqOp(t, want, have)
+		})
+		t.Run("empty body", func(t *testing.T) {
+			t.Parallel()
+			have := hostingdomain.UpdateStackTable("", table)
+			must.EqOp(t, table, have)
+		})
+		t.Run("body with table", func(t *testing.T) {
+			t.Parallel()
+			give := "description\n\n" + hostingdomain.StackTableStart + "\nold table\n" + hostingdomain.StackTableEnd + "\n\nfooter"
+			have := hostingdomain.UpdateStackTable(give, table)
+			want := "description\n\n" + table + "\n\nfooter"
+			must.EqOp(t, want, have)
+		})
+		t.Run("remove table", func(t *testing.T) {
+			t.Parallel()
+			give := "description\n\n" + hostingdomain.StackTableStart + "\nold table\n" + hostingdomain.StackTableEnd
+			have := hostingdomain.UpdateStackTable(give, "")
+			must.EqOp(t, "description", have)
+		})
+		t.Run("no table to remove", func(t *testing.T) {
+			t.Parallel()
+			have := hostingdomain.UpdateStackTable("description", "")
+			must.EqOp(t, "description", have)
+		})
+	})
+}
@@ -7,7 +7,10 @@ func UnsupportedServiceError() error {
 	return errors.New(`unsupported hosting platform
 
 This command requires hosting on one of these services:
+* Azure DevOps
 * Bitbucket
+* Bitbucket Data Center
+* Forgejo
 * GitHub
 * GitLab
 * Gitea`)
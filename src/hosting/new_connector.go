@@ -4,7 +4,10 @@ import (
 	"github.com/git-town/git-town/v12/src/cli/print"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git/giturl"
+	"github.com/git-town/git-town/v12/src/hosting/azuredevops"
 	"github.com/git-town/git-town/v12/src/hosting/bitbucket"
+	"github.com/git-town/git-town/v12/src/hosting/bitbucketdatacenter"
+	"github.com/git-town/git-town/v12/src/hosting/forgejo"
 	"github.com/git-town/git-town/v12/src/hosting/gitea"
 	"github.com/git-town/git-town/v12/src/hosting/github"
 	"github.com/git-town/git-town/v12/src/hosting/gitlab"
@@ -14,9 +17,33 @@ import (
 // NewConnector provides an instance of the code hosting connector to use based on the given gitConfig.
 func NewConnector(args NewConnectorArgs) (hostingdomain.Connector, error) {
 	switch Detect(args.OriginURL, args.HostingPlatform) {
+	case configdomain.HostingPlatformAzureDevOps:
+		return azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:        args.AzureDevOpsToken,
+			HostingPlatform: args.HostingPlatform,
+			Log:             args.Log,
+			OriginURL:       args.OriginURL,
+		})
 	case configdomain.HostingPlatformBitbucket:
 		return bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+			AppPassword:     args.BitbucketAppPassword,
 			HostingPlatform: args.HostingPlatform,
+			Log:             args.Log,
+			OriginURL:       args.OriginURL,
+			Username:        args.BitbucketUsername,
+		})
+	case configdomain.HostingPlatformBitbucketDatacenter:
+		return bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+			APIToken:        args.BitbucketDatacenterToken,
+			HostingPlatform: args.HostingPlatform,
+			Log:             args.Log,
+			OriginURL:       args.OriginURL,
+		})
+	case configdomain.HostingPlatformForgejo:
+		return forgejo.NewConnector(forgejo.NewConnectorArgs{
+			APIToken:        args.ForgejoToken,
+			HostingPlatform: args.HostingPlatform,
+			Log:             args.Log,
 			OriginURL:       args.OriginURL,
 		})
 	case configdomain.HostingPlatformGitea:
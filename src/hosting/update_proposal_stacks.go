@@ -0,0 +1,113 @@
+package hosting
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// UpdateProposalStacks updates the stack tables in the bodies of all proposals
+// in the stacks that the given branches are part of.
+func UpdateProposalStacks(args UpdateProposalStacksArgs) error {
+	proposals := proposalCache{connector: args.Connector, proposals: map[gitdomain.LocalBranchName]*hostingdomain.Proposal{}}
+	updated := gitdomain.LocalBranchNames{}
+	for _, branch := range args.Branches {
+		for _, stackBranch := range stackBranches(branch, args.Config) {
+			if updated.Contains(stackBranch) {
+				continue
+			}
+			updated = append(updated, stackBranch)
+			proposal, err := proposals.find(stackBranch)
+			if err != nil {
+				return err
+			}
+			if proposal == nil {
+				continue
+			}
+			entries := []hostingdomain.StackTableEntry{}
+			for _, member := range stackBranches(stackBranch, args.Config) {
+				memberProposal, err := proposals.find(member)
+				if err != nil {
+					return err
+				}
+				if memberProposal == nil {
+					continue
+				}
+				entries = append(entries, hostingdomain.StackTableEntry{
+					Current: member == stackBranch,
+					Level:   stackLevel(member, args.Config),
+					Text:    args.Connector.DefaultProposalMessage(*memberProposal),
+				})
+			}
+			body := hostingdomain.UpdateStackTable(proposal.Body, hostingdomain.StackTable(entries))
+			if body == proposal.Body {
+				continue
+			}
+			if err = args.Connector.UpdateProposalBody(proposal.Number, body); err != nil {
+				return err
+			}
+			proposal.Body = body
+		}
+	}
+	return nil
+}
+
+type UpdateProposalStacksArgs struct {
+	Branches  gitdomain.LocalBranchNames
+	Config    *configdomain.FullConfig
+	Connector hostingdomain.Connector
+}
+
+// proposalCache looks up the proposals of branches, querying the code hosting platform only once per branch.
+type proposalCache struct {
+	connector hostingdomain.Connector
+	proposals map[gitdomain.LocalBranchName]*hostingdomain.Proposal
+}
+
+func (self *proposalCache) find(branch gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	if proposal, has := self.proposals[branch]; has {
+		return proposal, nil
+	}
+	// the proposal might still target the previous parent of the branch
+	proposal, err := self.connector.FindProposal(branch, gitdomain.EmptyLocalBranchName())
+	if err != nil {
+		return nil, fmt.Errorf(messages.ProposalNotFoundForBranch, branch, err)
+	}
+	self.proposals[branch] = proposal
+	return proposal, nil
+}
+
+// stackBranches provides the feature branches in the stack of the given branch, ordered hierarchically.
+// A stack consists of a feature branch whose parent is a main or perennial branch and all its descendants,
+// so it also contains the siblings of the given branch and their descendants.
+// Main and perennial branches aren't part of a stack, they provide the stacks of all their children.
+func stackBranches(branch gitdomain.LocalBranchName, config *configdomain.FullConfig) gitdomain.LocalBranchNames {
+	root := branch
+	for _, ancestor := range config.Lineage.Ancestors(branch) {
+		if !config.IsMainOrPerennialBranch(ancestor) {
+			root = ancestor
+			break
+		}
+	}
+	result := gitdomain.LocalBranchNames{}
+	for _, stackBranch := range append(gitdomain.LocalBranchNames{root}, config.Lineage.Descendants(root)...) {
+		if !config.IsMainOrPerennialBranch(stackBranch) {
+			result = append(result, stackBranch)
+		}
+	}
+	return result
+}
+
+// stackLevel provides how deep the given branch is nested in its stack.
+func stackLevel(branch gitdomain.LocalBranchName, config *configdomain.FullConfig) int {
+	result := 0
+	for _, ancestor := range config.Lineage.Ancestors(branch) {
+		if !config.IsMainOrPerennialBranch(ancestor) {
+			result++
+		}
+	}
+	return result
+}
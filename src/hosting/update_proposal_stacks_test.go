@@ -0,0 +1,242 @@
+package hosting_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestUpdateProposalStacks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stack with siblings", func(t *testing.T) {
+		t.Parallel()
+		config := stackConfig(configdomain.Lineage{
+			gitdomain.NewLocalBranchName("alpha"): gitdomain.NewLocalBranchName("main"),
+			gitdomain.NewLocalBranchName("beta"):  gitdomain.NewLocalBranchName("alpha"),
+			gitdomain.NewLocalBranchName("gamma"): gitdomain.NewLocalBranchName("alpha"),
+			gitdomain.NewLocalBranchName("delta"): gitdomain.NewLocalBranchName("beta"),
+		})
+		connector := newFakeConnector("alpha", "beta", "gamma", "delta")
+		err := hosting.UpdateProposalStacks(hosting.UpdateProposalStacksArgs{
+			Branches:  gitdomain.NewLocalBranchNames("delta"),
+			Config:    &config,
+			Connector: connector,
+		})
+		must.NoError(t, err)
+		want := map[int]string{
+			1: stackBody("- alpha (#1) ← this proposal", "  - beta (#2)", "    - delta (#4)", "  - gamma (#3)"),
+			2: stackBody("- alpha (#1)", "  - beta (#2) ← this proposal", "    - delta (#4)", "  - gamma (#3)"),
+			3: stackBody("- alpha (#1)", "  - beta (#2)", "    - delta (#4)", "  - gamma (#3) ← this proposal"),
+			4: stackBody("- alpha (#1)", "  - beta (#2)", "    - delta (#4) ← this proposal", "  - gamma (#3)"),
+		}
+		must.Eq(t, want, connector.updatedBodies)
+	})
+
+	t.Run("separate stacks", func(t *testing.T) {
+		t.Parallel()
+		config := stackConfig(configdomain.Lineage{
+			gitdomain.NewLocalBranchName("alpha"): gitdomain.NewLocalBranchName("main"),
+			gitdomain.NewLocalBranchName("beta"):  gitdomain.NewLocalBranchName("alpha"),
+			gitdomain.NewLocalBranchName("other"): gitdomain.NewLocalBranchName("main"),
+		})
+		connector := newFakeConnector("alpha", "beta", "other")
+		err := hosting.UpdateProposalStacks(hosting.UpdateProposalStacksArgs{
+			Branches:  gitdomain.NewLocalBranchNames("beta"),
+			Config:    &config,
+			Connector: connector,
+		})
+		must.NoError(t, err)
+		want := map[int]string{
+			1: stackBody("- alpha (#1) ← this proposal", "  - beta (#2)"),
+			2: stackBody("- alpha (#1)", "  - beta (#2) ← this proposal"),
+		}
+		must.Eq(t, want, connector.updatedBodies)
+	})
+
+	t.Run("stacks on a perennial branch", func(t *testing.T) {
+		t.Parallel()
+		config := stackConfig(configdomain.Lineage{
+			gitdomain.NewLocalBranchName("alpha"): gitdomain.NewLocalBranchName("main"),
+			gitdomain.NewLocalBranchName("beta"):  gitdomain.NewLocalBranchName("alpha"),
+			gitdomain.NewLocalBranchName("gamma"): gitdomain.NewLocalBranchName("qa"),
+			gitdomain.NewLocalBranchName("delta"): gitdomain.NewLocalBranchName("gamma"),
+		})
+		connector := newFakeConnector("alpha", "beta", "gamma", "delta")
+		err := hosting.UpdateProposalStacks(hosting.UpdateProposalStacksArgs{
+			Branches:  gitdomain.NewLocalBranchNames("qa"),
+			Config:    &config,
+			Connector: connector,
+		})
+		must.NoError(t, err)
+		want := map[int]string{
+			3: stackBody("- gamma (#3) ← this proposal", "  - delta (#4)"),
+			4: stackBody("- gamma (#3)", "  - delta (#4) ← this proposal"),
+		}
+		must.Eq(t, want, connector.updatedBodies)
+	})
+
+	t.Run("branches without proposals", func(t *testing.T) {
+		t.Parallel()
+		config := stackConfig(configdomain.Lineage{
+			gitdomain.NewLocalBranchName("alpha"): gitdomain.NewLocalBranchName("main"),
+			gitdomain.NewLocalBranchName("beta"):  gitdomain.NewLocalBranchName("alpha"),
+			gitdomain.NewLocalBranchName("gamma"): gitdomain.NewLocalBranchName("beta"),
+		})
+		connector := newFakeConnector("alpha", "", "gamma")
+		err := hosting.UpdateProposalStacks(hosting.UpdateProposalStacksArgs{
+			Branches:  gitdomain.NewLocalBranchNames("alpha"),
+			Config:    &config,
+			Connector: connector,
+		})
+		must.NoError(t, err)
+		want := map[int]string{
+			1: stackBody("- alpha (#1) ← this proposal", "    - gamma (#3)"),
+			3: stackBody("- alpha (#1)", "    - gamma (#3) ← this proposal"),
+		}
+		must.Eq(t, want, connector.updatedBodies)
+	})
+
+	t.Run("proposals with up-to-date stack tables", func(t *testing.T) {
+		t.Parallel()
+		config := stackConfig(configdomain.Lineage{
+			gitdomain.NewLocalBranchName("alpha"): gitdomain.NewLocalBranchName("main"),
+			gitdomain.NewLocalBranchName("beta"):  gitdomain.NewLocalBranchName("alpha"),
+		})
+		connector := newFakeConnector("alpha", "beta")
+		args := hosting.UpdateProposalStacksArgs{
+			Branches:  gitdomain.NewLocalBranchNames("alpha"),
+			Config:    &config,
+			Connector: connector,
+		}
+		must.NoError(t, hosting.UpdateProposalStacks(args))
+		connector.updatedBodies = map[int]string{}
+		must.NoError(t, hosting.UpdateProposalStacks(args))
+		must.MapEmpty(t, connector.updatedBodies)
+	})
+
+	t.Run("proposal cannot be loaded", func(t *testing.T) {
+		t.Parallel()
+		config := stackConfig(configdomain.Lineage{
+			gitdomain.NewLocalBranchName("alpha"): gitdomain.NewLocalBranchName("main"),
+		})
+		connector := newFakeConnector("alpha")
+		connector.findErr = errors.New("boom")
+		err := hosting.UpdateProposalStacks(hosting.UpdateProposalStacksArgs{
+			Branches:  gitdomain.NewLocalBranchNames("alpha"),
+			Config:    &config,
+			Connector: connector,
+		})
+		must.EqError(t, err, `cannot determine proposal for branch "alpha": boom`)
+	})
+}
+
+// fakeConnector is a hostingdomain.Connector that serves proposals from memory
+// and records the proposal bodies it receives.
+type fakeConnector struct {
+	findErr       error
+	proposals     map[gitdomain.LocalBranchName]*hostingdomain.Proposal
+	updatedBodies map[int]string
+}
+
+// newFakeConnector provides a fakeConnector that knows proposals for the given branches,
+// numbered by their position. Empty branch names leave a gap.
+func newFakeConnector(branches ...string) *fakeConnector {
+	proposals := map[gitdomain.LocalBranchName]*hostingdomain.Proposal{}
+	for b, branch := range branches {
+		if branch == "" {
+			continue
+		}
+		proposals[gitdomain.NewLocalBranchName(branch)] = &hostingdomain.Proposal{ //nolint:exhaustruct
+			Number: b + 1,
+			Title:  branch,
+		}
+	}
+	return &fakeConnector{
+		findErr:       nil,
+		proposals:     proposals,
+		updatedBodies: map[int]string{},
+	}
+}
+
+func (self *fakeConnector) CloseProposal(_ int) error {
+	return errors.New("unexpected call to CloseProposal")
+}
+
+func (self *fakeConnector) CreateProposal(_, _ gitdomain.LocalBranchName, _, _ string, _ bool) (hostingdomain.Proposal, error) {
+	return hostingdomain.Proposal{}, errors.New("unexpected call to CreateProposal") //nolint:exhaustruct
+}
+
+func (self *fakeConnector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
+}
+
+func (self *fakeConnector) FastForwardProposal(_ int) error {
+	return errors.New("unexpected call to FastForwardProposal")
+}
+
+func (self *fakeConnector) FindProposal(branch, _ gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	if self.findErr != nil {
+		return nil, self.findErr
+	}
+	proposal, has := self.proposals[branch]
+	if !has {
+		return nil, nil //nolint:nilnil
+	}
+	// return a copy so that the caller cannot modify the stored proposal
+	result := *proposal
+	return &result, nil
+}
+
+func (self *fakeConnector) MergeCommitProposal(_ int, _ string) error {
+	return errors.New("unexpected call to MergeCommitProposal")
+}
+
+func (self *fakeConnector) NewProposalURL(_, _ gitdomain.LocalBranchName) (string, error) {
+	return "", errors.New("unexpected call to NewProposalURL")
+}
+
+func (self *fakeConnector) RepositoryURL() string {
+	return ""
+}
+
+func (self *fakeConnector) SquashMergeProposal(_ int, _ string) error {
+	return errors.New("unexpected call to SquashMergeProposal")
+}
+
+func (self *fakeConnector) UpdateProposalBody(number int, body string) error {
+	self.updatedBodies[number] = body
+	for _, proposal := range self.proposals {
+		if proposal.Number == number {
+			proposal.Body = body
+		}
+	}
+	return nil
+}
+
+func (self *fakeConnector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
+	return errors.New("unexpected call to UpdateProposalTarget")
+}
+
+// stackBody provides the body of a proposal that contains only a stack table with the given lines.
+func stackBody(lines ...string) string {
+	result := hostingdomain.StackTableStart + "\nThis proposal is part of a stack:\n\n"
+	for _, line := range lines {
+		result += line + "\n"
+	}
+	return result + hostingdomain.StackTableEnd
+}
+
+func stackConfig(lineage configdomain.Lineage) configdomain.FullConfig {
+	return configdomain.FullConfig{ //nolint:exhaustruct
+		Lineage:           lineage,
+		MainBranch:        gitdomain.NewLocalBranchName("main"),
+		PerennialBranches: gitdomain.NewLocalBranchNames("qa"),
+	}
+}
@@ -2,8 +2,14 @@ package messages
 
 const (
 	UndoContinueGuidance               = "\n\nTo continue after having resolved conflicts, run \"git town continue\".\nTo go back to where you started, run \"git town undo\".\n"
+	AheadBehindCountsProblem           = "cannot determine how far branch %q is ahead or behind its tracking branch: %w"
+	AheadBehindCountsUnexpectedOutput  = "unexpected output when counting the commits ahead and behind the tracking branch of %q: %q"
 	AliasedCommands                    = "Aliased commands: %s\n"
 	ArgumentUnknown                    = "unknown argument: %q"
+	AzureDevOpsToken                   = "Azure DevOps token: %s\n"
+	BitbucketAppPassword               = "Bitbucket app password: %s\n"
+	BitbucketDatacenterToken           = "Bitbucket Data Center token: %s\n"
+	BitbucketUsername                  = "Bitbucket username: %s\n"
 	BranchAlreadyExistsLocally         = "there is already a branch %q"
 	BranchAlreadyExistsRemotely        = "there is already a branch %q at the \"origin\" remote"
 	BranchAuthorMultiple               = "\nMultiple people authored the %q branch.\n\n"
@@ -18,21 +24,29 @@ const (
 	BranchIsAlreadyContribution        = "branch %q is already a contribution branch"
 	BranchIsAlreadyObserved            = "branch %q is already observed"
 	BranchIsAlreadyParked              = "branch %q is already parked"
+	BranchIsAlreadyPrototype           = "branch %q is already a prototype branch"
 	BranchLocalSHAProblem              = "cannot determine SHA of local branch %q: %w"
 	BranchLocalProblem                 = "cannot determine whether the local branch %q exists: %w"
+	BranchMergedProblem                = "cannot determine whether the changes of branch %q are merged: %w"
+	BranchNotInSync                    = "branch %q is not in sync, please run \"git town sync\" first"
 	BranchParentChanged                = "branch %q is now a child of %q"
 	BrowserOpen                        = "Please open in a browser: %s\n"
 	CacheUnitialized                   = "using a cached value before initialization"
+	ChildBranchDialogSelected          = "Selected child branch of %q: %s\n"
 	CodeHosting                        = "Code hosting: %s\n"
 	CommandsRun                        = "Ran %d shell commands."
 	CommitMessageProblem               = "cannot determine last commit message: %w"
 	CompletionTypeUnknown              = "unknown completion type: %q"
+	CompressAlreadyOneCommit           = "branch %q already has just one commit"
+	CompressBranchType                 = "cannot compress %s %q"
+	CompressNoCommits                  = "branch %q has no commits"
 	ConfigFileCannotRead               = "cannot read the configuration file %q: %w"
 	ConfigFileInvalidData              = "the configuration file %q does not contain TOML-formatted content: %w"
 	ConfigMainbranchInConfigFile       = "please configure the main branch in the config file"
 	ConfigNeeded                       = "Git Town needs to be configured\n\n"
 	ConfigStorage                      = "Config storage: %s\n"
 	ConfigSyncFeatureStrategyUnknown   = "unknown sync-feature strategy: %q"
+	ConfigShipStrategyUnknown          = "unknown ship strategy: %q"
 	ConfigSyncPerennialStrategyUnknown = "unknown sync-perennial strategy: %q"
 	ConfigRemoveError                  = "unexpected error while removing the 'git-town' section from the Git configuration: %w"
 	ContinueMessage                    = `You can run "git town continue" to finish it.`
@@ -41,6 +55,8 @@ const (
 	ContributionBranchCannotPark       = "cannot park contribution branches"
 	ContributionBranchCannotPropose    = "cannot propose contribution branches"
 	ContributionBranchCannotShip       = "cannot ship contribution branches"
+	DetachBranchType                   = "cannot detach %s %q"
+	DetachParentIsMain                 = "branch %q is already a child of the main branch"
 	DiffConflictWithMain               = "conflicts between your uncommmitted changes and the main branch"
 	DryRun                             = "In dry run mode. No commands will be run. When run in normal mode, the command output will appear beneath the command. Some commands will only be run if necessary. For example: 'git push' will run if and only if there are local commits not on origin."
 	ValueInvalid                       = "invalid value for %s: %q. Please provide either \"yes\" or \"no\""
@@ -58,6 +74,7 @@ const (
 	FileReadProblem                    = "cannot read file %q: %w"
 	FileStatProblem                    = "cannot check file %q: %w"
 	FileWriteProblem                   = "cannot write file %q: %w"
+	ForgejoToken                       = "Forgejo token: %s\n"
 	GiteaToken                         = "Gitea token: %s\n"
 	GitHubToken                        = "GitHub token: %s\n"
 	GitLabToken                        = "GitLab token: %s\n"
@@ -84,12 +101,34 @@ END OUTPUT FROM 'git branch -vva'
 	HackBranchIsNowFeature                = "branch %q is now a feature branch\n"
 	HackCannotFeatureMainBranch           = "cannot make the main branch a feature branch"
 	HackCannotFeaturePerennialBranch      = "branch %q is a perennial branch and therefore be a feature branch"
-	HostingBitBucketNotImplemented        = "shipping pull requests via the Bitbucket API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
+	HackPrototypeExistingBranch           = "the --prototype flag only applies to new branches, please use \"git town prototype\" to make existing branches prototype branches"
+	HistoryEmpty                          = "no Git Town commands have run in this repository yet"
+	HistoryNoBranchesChanged              = "(no branches changed)"
+	HostingAzureDevOpsAPIError            = "Azure DevOps API responded with status %d: %s"
+	HostingAzureDevOpsClosePRViaAPI       = "Azure DevOps API: Abandoning PR #%d ... "
+	HostingAzureDevOpsCreatingPRViaAPI    = "Azure DevOps API: Creating PR for branch %q ... "
+	HostingAzureDevOpsMergingViaAPI       = "Azure DevOps API: Completing PR #%d ... "
+	HostingAzureDevOpsUpdatePRBodyViaAPI  = "Azure DevOps API: Updating description of PR #%d ... "
+	HostingAzureDevOpsUpdatePRViaAPI      = "Azure DevOps API: Updating target branch of PR #%d to %q ... "
+	HostingBitbucketAPIError              = "Bitbucket API responded with status %d: %s"
+	HostingBitbucketClosePRViaAPI         = "Bitbucket API: Declining PR #%d ... "
+	HostingBitbucketCreatingPRViaAPI      = "Bitbucket API: Creating PR for branch %q ... "
+	HostingBitbucketMergingViaAPI         = "Bitbucket API: Merging PR #%d ... "
+	HostingBitbucketUpdatePRBodyViaAPI    = "Bitbucket API: Updating description of PR #%d ... "
+	HostingBitbucketUpdatePRViaAPI        = "Bitbucket API: Updating target branch of PR #%d to %q ... "
+	HostingGiteaClosePRViaAPI             = "Gitea API: Closing PR #%d ... "
+	HostingGitlabCloseMRViaAPI            = "GitLab API: Closing MR !%d ... "
+	HostingGitlabCreatingMRViaAPI         = "GitLab API: Creating MR for branch %q ... "
 	HostingGitlabMergingViaAPI            = "GitLab API: Merging MR !%d ... "
+	HostingGitlabUpdateMRBodyViaAPI       = "GitLab API: Updating description of MR !%d ... "
 	HostingGitlabUpdateMRViaAPI           = "GitLab API: Updating target branch for MR !%d to %q ... "
-	HostingGiteaNotImplemented            = "shipping pull requests via the Gitea API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
-	HostingGiteaUpdatePRViaAPI            = "Gitea API: Updating base branch for PR #%d to #%s"
+	HostingGiteaCreatingPRViaAPI          = "Gitea API: Creating PR for branch %q ... "
+	HostingGiteaUpdatePRBodyViaAPI        = "Gitea API: Updating body of PR #%d ... "
+	HostingGiteaUpdatePRViaAPI            = "Gitea API: Updating base branch for PR #%d to %q ... "
+	HostingGithubClosePRViaAPI            = "GitHub API: closing PR #%d ... "
+	HostingGithubCreatingPRViaAPI         = "GitHub API: creating PR for branch %q ... "
 	HostingGithubMergingViaAPI            = "GitHub API: merging PR #%d ... "
+	HostingGithubUpdatePRBodyViaAPI       = "GitHub API: updating body of PR #%d ... "
 	HostingGithubUpdatePRViaAPI           = "GitHub API: updating base branch for PR #%d ... "
 	HostingPlatformUnknown                = "unknown hosting platform: %q"
 	InputAddOrRemove                      = `invalid argument %q. Please provide either "add" or "remove"`
@@ -102,7 +141,14 @@ END OUTPUT FROM 'git branch -vva'
 	MainBranchCannotObserve               = "cannot observe the main branch"
 	MainBranchCannotPark                  = "cannot park the main branch"
 	MainBranchCannotPropose               = "cannot propose the main branch"
+	MainBranchCannotPrototype             = "cannot make the main branch a prototype branch"
 	MainBranchCannotShip                  = "cannot ship the main branch"
+	MergeBranchType                       = "cannot merge %s %q"
+	MergeIntoBranchType                   = "cannot merge into %s %q"
+	NavigateAlreadyAtBottom               = "branch %q is already at the bottom of its stack\n"
+	NavigateAlreadyAtTop                  = "branch %q is already at the top of its stack\n"
+	NavigateNoChildren                    = "branch %q has no child branches"
+	NavigateNoParent                      = "branch %q has no parent branch"
 	ObservedBranchCannotPark              = "cannot park observed branches"
 	ObservedBranchCannotPropose           = "cannot propose observed branches"
 	ObservedBranchCannotShip              = "cannot ship observed branches"
@@ -117,17 +163,32 @@ END OUTPUT FROM 'git branch -vva'
 	PerennialBranchCannotObserve          = "cannot observe perennial branches"
 	PerennialBranchCannotPark             = "cannot park perennial branches"
 	PerennialBranchCannotPropose          = "cannot propose perennial branches"
+	PerennialBranchCannotPrototype        = "cannot make perennial branches prototype branches"
 	PerennialBranchCannotShip             = "cannot ship perennial branches"
 	PerennialBranches                     = "Perennial branches: %s\n"
 	PerennialBranchRemovedParentEntry     = "Removed parent entry for perennial branch %q\n"
 	PerennialRegex                        = "Perennial regex: %s\n"
 	PreviousCommandFinished               = "The previous Git Town command (%s) finished successfully.\n"
 	PreviousCommandProblem                = "The last Git Town command (%s) hit a problem %v ago.\n"
+	ProposalBodyFileProblem               = "cannot read the proposal body from file %q: %w"
+	ProposalBodyFlags                     = "the --body and --body-file flags cannot be used together"
+	ProposalCloseProblem                  = "cannot close proposal %d via the API"
+	ProposalCreateDryRun                  = "creating proposal for branch %q via the API ... "
+	ProposalCreated                       = "created proposal %s"
+	ProposalExists                        = "found existing proposal %s"
 	ProposalMultipleFound                 = "found %d proposals from branch %q to branch %q"
 	ProposalNoNumberGiven                 = "no proposal number given"
 	ProposalNotFoundForBranch             = "cannot determine proposal for branch %q: %w"
 	ProposalTargetBranchUpdateProblem     = "cannot update the target branch of proposal %d via the API"
+	ProposalTargetUpdateDryRun            = "updating target branch of proposal %d to %q via the API ... "
 	ProposalURLProblem                    = "cannot determine proposal URL from %q to %q: %w"
+	ProposeStackNoAPIToken                = "proposing a stack requires an API token for your code hosting platform"
+	ProposeStackParkedBranchNotPushed     = "cannot propose the stack because the parked branch %q doesn't exist at the remote yet, please unpark it or push it first"
+	ProposeStackTitle                     = "the --title flag cannot be used with --stack because each proposal needs its own title"
+	PrototypeBranchCannotShip             = "cannot ship prototype branches, please propose them first"
+	PrototypeBranchIsNowPrototype         = "branch %q is now a prototype branch\n"
+	PruneBranches                         = "Prune branches: %s\n"
+	PruneNoBranches                       = "no branches to prune"
 	PullRequestDeprecation                = `DEPRECATION NOTICE
 
 This command has been renamed to "git town propose"
@@ -135,6 +196,9 @@ nd will be removed in future versions of Git Town.`
 	PushHook                       = "Push hook: %s\n"
 	PushNewBranches                = "Push new branches: %s\n"
 	RebaseProblem                  = "cannot determine rebase in progress: %w"
+	RedoBranchesChanged            = "cannot redo because these branches have changed since the undo: %s"
+	RedoConfigChanged              = "cannot redo because the Git Town configuration has changed since the undo"
+	RedoNothingToDo                = "nothing to redo"
 	RemoteExistsProblem            = "cannot determine if remote %q exists: %w"
 	RemotesProblem                 = "cannot determine remotes: %w"
 	RenameBranchNotInSync          = "%q is not in sync with its tracking branch, please sync the branches before renaming"
@@ -169,7 +233,10 @@ I am upgrading this setting to the new format %q.
 	ShipBranchNothingToDo       = "the branch %q has no shippable changes"
 	ShipChildBranch             = "shipping this branch would ship %s as well,\nplease ship %q first"
 	ShipDeletesTrackingBranches = "Ship deletes tracking branches: %s\n"
+	ShipFastForwardFailed       = "cannot fast-forward to branch %q, please sync it and try again"
+	ShipFastForwardUndo         = "cannot undo the fast-forward of branch %q, please revert the shipped commits on it manually"
 	ShipOpenChanges             = "you have uncommitted changes. Did you mean to commit them before shipping?"
+	ShipStackMessage            = "the --message flag cannot be used with --stack because each shipped branch needs its own commit message"
 	ShippableChangesProblem     = "cannot determine whether branch %q has shippable changes: %w"
 	SkipBranchHasConflicts      = "cannot skip branch that resulted in conflicts"
 	SkipMessage                 = `You can run "git town skip" to skip the currently failing operation.`
@@ -180,6 +247,9 @@ I am upgrading this setting to the new format %q.
 	SquashCommitAuthorSelection = "Selected squash commit author: %s\n"
 	SquashMessageProblem        = "cannot comment out the squash commit message: %w"
 	StatusFileNotFound          = "No status file found for this repository."
+	SwapBranchType              = "cannot swap %s %q"
+	SyncAllAndBranches          = "the --all flag cannot be used together with branch names"
+	SyncAllAndStack             = "the --all and --stack flags cannot be used together"
 	SyncBeforeShip              = "Sync before ship: %s\n"
 	SyncFeatureBranches         = "Sync feature branches: %s\n"
 	SyncPerennialBranches       = "Sync perennial branches: %s\n"
@@ -188,10 +258,16 @@ I am upgrading this setting to the new format %q.
 	UndoCreateOpcodeProblem     = "cannot create undo operations for %q: %w"
 	UndoMessage                 = `You can run "git town undo" to go back to where you started.`
 	UndoNothingToDo             = "nothing to undo"
+	UndoStepsInconsistent       = "cannot undo %d commands because these branches have been changed outside of Git Town in between: %s"
+	UndoStepsInvalid            = "the number of commands to undo must be at least 1"
+	UndoStepsTooMany            = "cannot undo %d commands because the history contains only %d"
+	UndoStepsUnfinished         = "cannot undo multiple commands while the previous Git Town command is unfinished"
 	UnfinishedCommandHandle     = "Handle unfinished command: %s\n"
 	UnfinishedRunStateContinue  = "Continue the \"%s\" command after having resolved conflicts"
 	UnfinishedRunStateDiscard   = "Discard the unfinished state and run the new command"
 	UnfinishedRunStateQuit      = "Quit without running anything"
 	UnfinishedRunStateSkip      = "Skip the current branch and continue the \"%s\" command on the next branch"
 	UnfinishedRunStateUndo      = "Undo the previous \"%s\" command"
+	WalkCommandFailed           = "command %q failed: %w"
+	WalkNoBranchSelection       = "please provide either --all or --stack to select the branches to walk"
 )
@@ -63,6 +63,8 @@ func ExistingBranchProgram(list *program.Program, branch gitdomain.BranchInfo, p
 		ContributionBranchProgram(args.Program, branch)
 	case configdomain.BranchTypeObservedBranch:
 		ObservedBranchProgram(branch, args.Program)
+	case configdomain.BranchTypePrototypeBranch:
+		PrototypeBranchProgram(list, branch.LocalName, parentOtherWorktree)
 	}
 	if args.PushBranch && args.Remotes.HasOrigin() && args.Config.IsOnline() && branchType.ShouldPush(branch.LocalName, args.InitialBranch) {
 		switch {
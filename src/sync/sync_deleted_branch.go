@@ -13,7 +13,7 @@ import (
 // syncDeletedBranchProgram adds opcodes that sync a branch that was deleted at origin to the given program.
 func syncDeletedBranchProgram(list *program.Program, branch gitdomain.BranchInfo, parentOtherWorktree bool, args BranchProgramArgs) {
 	switch args.Config.BranchType(branch.LocalName) {
-	case configdomain.BranchTypeFeatureBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypePrototypeBranch:
 		syncDeletedFeatureBranchProgram(list, branch, parentOtherWorktree, args)
 	case configdomain.BranchTypePerennialBranch, configdomain.BranchTypeMainBranch:
 		syncDeletedPerennialBranchProgram(list, branch, args)
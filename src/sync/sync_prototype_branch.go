@@ -0,0 +1,16 @@
+package sync
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+)
+
+// PrototypeBranchProgram adds the opcodes to sync the prototype branch with the given name.
+// Prototype branches exist only locally, so they get rebased onto their parent without involving their tracking branch.
+func PrototypeBranchProgram(prog *program.Program, branch gitdomain.LocalBranchName, parentOtherWorktree bool) {
+	prog.Add(&opcodes.RebaseParent{
+		CurrentBranch:               branch,
+		ParentActiveInOtherWorktree: parentOtherWorktree,
+	})
+}
@@ -0,0 +1,98 @@
+package undo
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/git"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	lightInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/light"
+	"github.com/git-town/git-town/v12/src/vm/program"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/git-town/git-town/v12/src/vm/statefile"
+)
+
+// ExecuteHistory undoes the given number of most recent Git Town commands in the history
+// and removes them from the history.
+func ExecuteHistory(args ExecuteHistoryArgs) error {
+	undoProgram := program.Program{}
+	for _, entry := range args.History.Last(args.Steps) {
+		undoProgram.AddProgram(CreateUndoForFinishedProgram(CreateUndoProgramArgs{
+			DryRun:         args.Runner.Config.DryRun,
+			HasOpenChanges: args.HasOpenChanges,
+			NoPushHook:     args.FullConfig.NoPushHook(),
+			Run:            args.Runner,
+			RunState:       entry.RunState,
+		}))
+	}
+	lightInterpreter.Execute(undoProgram, args.Runner, args.Lineage)
+	err := statefile.Delete(args.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateDeleteProblem, err)
+	}
+	err = statefile.SaveHistory(args.History.RemoveLast(args.Steps), args.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSaveProblem, err)
+	}
+	err = saveRedo(args)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSaveProblem, err)
+	}
+	print.Footer(args.Verbose, args.Runner.CommandsCounter.Count(), args.Runner.FinalMessages.Result())
+	return nil
+}
+
+type ExecuteHistoryArgs struct {
+	FullConfig              *configdomain.FullConfig
+	HasOpenChanges          bool
+	History                 runstate.History
+	InitialBranchesSnapshot gitdomain.BranchesSnapshot
+	InitialConfigSnapshot   undoconfig.ConfigSnapshot
+	InitialStashSize        gitdomain.StashSize
+	Lineage                 configdomain.Lineage
+	RootDir                 gitdomain.RepoRootDir
+	Runner                  *git.ProdRunner
+	Steps                   int
+	Verbose                 bool
+}
+
+// saveRedo stores how the undo changed the repo, so that "git town redo" can reverse it.
+func saveRedo(args ExecuteHistoryArgs) error {
+	endBranchesSnapshot, err := args.Runner.Backend.BranchesSnapshot()
+	if err != nil {
+		return err
+	}
+	configGitAccess := gitconfig.Access{Runner: args.Runner.Backend.Runner}
+	globalSnapshot, _, err := configGitAccess.LoadGlobal()
+	if err != nil {
+		return err
+	}
+	localSnapshot, _, err := configGitAccess.LoadLocal()
+	if err != nil {
+		return err
+	}
+	endStashSize, err := args.Runner.Backend.StashSize()
+	if err != nil {
+		return err
+	}
+	redoRunState := runstate.RunState{
+		BeginBranchesSnapshot: args.InitialBranchesSnapshot,
+		BeginConfigSnapshot:   args.InitialConfigSnapshot,
+		BeginStashSize:        args.InitialStashSize,
+		Command:               "undo",
+		DryRun:                false,
+		EndBranchesSnapshot:   endBranchesSnapshot,
+		EndConfigSnapshot: undoconfig.ConfigSnapshot{
+			Global: globalSnapshot,
+			Local:  localSnapshot,
+		},
+		EndStashSize: endStashSize,
+		IsUndo:       true,
+		RunProgram:   program.Program{},
+	}
+	return statefile.SaveRedo(&redoRunState, args.RootDir)
+}
@@ -0,0 +1,37 @@
+package undo
+
+import (
+	"github.com/git-town/git-town/v12/src/undo/undobranches"
+	"github.com/git-town/git-town/v12/src/undo/undodomain"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+)
+
+// InconsistentHistoryChanges provides the branches changed by the given finished Git Town commands
+// that were modified outside of Git Town before a later one of the given commands ran.
+// Undoing these commands together would discard these modifications.
+// The given run states must be ordered most recent first.
+func InconsistentHistoryChanges(runStates []runstate.RunState) undodomain.InconsistentChanges {
+	result := undodomain.InconsistentChanges{}
+	// the most recent of the given commands that recorded its branches
+	var later *runstate.RunState
+	for r := range runStates {
+		earlier := &runStates[r]
+		if earlier.BeginBranchesSnapshot.IsEmpty() {
+			// commands that only change the configuration don't record their branches
+			continue
+		}
+		if later != nil {
+			changedBranches := undobranches.NewBranchSpans(earlier.BeginBranchesSnapshot, earlier.EndBranchesSnapshot).ChangedBranches()
+			for _, branchSpan := range undobranches.NewBranchSpans(earlier.EndBranchesSnapshot, later.BeginBranchesSnapshot) {
+				if !branchSpan.NoChanges() && changedBranches.Contains(branchSpan.BranchName()) {
+					result = append(result, undodomain.InconsistentChange{
+						Before: branchSpan.Before,
+						After:  branchSpan.After,
+					})
+				}
+			}
+		}
+		later = earlier
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package undo_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/undo"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestInconsistentHistoryChanges(t *testing.T) {
+	t.Parallel()
+
+	snapshot := func(branchSHAs ...string) gitdomain.BranchesSnapshot {
+		result := gitdomain.BranchesSnapshot{
+			Active:   gitdomain.NewLocalBranchName("main"),
+			Branches: gitdomain.BranchInfos{},
+		}
+		for i := 0; i < len(branchSHAs); i += 2 {
+			result.Branches = append(result.Branches, gitdomain.BranchInfo{
+				LocalName:  gitdomain.NewLocalBranchName(branchSHAs[i]),
+				LocalSHA:   gitdomain.NewSHA(branchSHAs[i+1]),
+				SyncStatus: gitdomain.SyncStatusLocalOnly,
+				RemoteName: gitdomain.EmptyRemoteBranchName(),
+				RemoteSHA:  gitdomain.EmptySHA(),
+			})
+		}
+		return result
+	}
+
+	newRunState := func(begin, end gitdomain.BranchesSnapshot) runstate.RunState {
+		result := runstate.EmptyRunState()
+		result.BeginBranchesSnapshot = begin
+		result.EndBranchesSnapshot = end
+		return result
+	}
+
+	t.Run("consecutive commands", func(t *testing.T) {
+		t.Parallel()
+		runStates := []runstate.RunState{
+			newRunState(snapshot("branch-1", "222222"), snapshot("branch-1", "333333")),
+			newRunState(snapshot("branch-1", "111111"), snapshot("branch-1", "222222")),
+		}
+		have := undo.InconsistentHistoryChanges(runStates)
+		must.Len(t, 0, have)
+	})
+
+	t.Run("branch changed outside of Git Town in between", func(t *testing.T) {
+		t.Parallel()
+		runStates := []runstate.RunState{
+			newRunState(snapshot("branch-1", "444444", "branch-2", "555555"), snapshot("branch-1", "444444", "branch-2", "666666")),
+			newRunState(snapshot("branch-1", "111111"), snapshot("branch-1", "222222")),
+		}
+		have := undo.InconsistentHistoryChanges(runStates)
+		must.Eq(t, gitdomain.NewLocalBranchNames("branch-1"), have.BranchNames())
+	})
+
+	t.Run("unrelated branch changed outside of Git Town in between", func(t *testing.T) {
+		t.Parallel()
+		runStates := []runstate.RunState{
+			newRunState(snapshot("branch-1", "222222", "branch-2", "444444"), snapshot("branch-1", "333333", "branch-2", "444444")),
+			newRunState(snapshot("branch-1", "111111", "branch-2", "555555"), snapshot("branch-1", "222222", "branch-2", "555555")),
+		}
+		have := undo.InconsistentHistoryChanges(runStates)
+		must.Len(t, 0, have)
+	})
+
+	t.Run("skips commands that only changed the configuration", func(t *testing.T) {
+		t.Parallel()
+		runStates := []runstate.RunState{
+			newRunState(snapshot("branch-1", "444444"), snapshot("branch-1", "555555")),
+			newRunState(gitdomain.EmptyBranchesSnapshot(), gitdomain.EmptyBranchesSnapshot()),
+			newRunState(snapshot("branch-1", "111111"), snapshot("branch-1", "222222")),
+		}
+		have := undo.InconsistentHistoryChanges(runStates)
+		must.Eq(t, gitdomain.NewLocalBranchNames("branch-1"), have.BranchNames())
+	})
+}
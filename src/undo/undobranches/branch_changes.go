@@ -77,9 +77,10 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// revert omni-changed perennial branches
 	for _, branch := range omniChangedPerennials.BranchNames() {
 		change := omniChangedPerennials[branch]
-		if slice.Contains(args.UndoablePerennialCommits, change.After) {
+		undoableCommits := args.UndoablePerennialCommits[branch]
+		if slice.Contains(undoableCommits, change.After) {
 			result.Add(&opcodes.Checkout{Branch: branch})
-			result.Add(&opcodes.RevertCommit{SHA: change.After})
+			revertCommits(&result, undoableCommits)
 			result.Add(&opcodes.PushCurrentBranch{CurrentBranch: branch})
 		}
 	}
@@ -104,9 +105,10 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// reset inconsintently changed perennial branches
 	for _, inconsistentlyChangedPerennial := range inconsistentlyChangedPerennials {
 		if inconsistentlyChangedPerennial.After.IsOmniBranch() {
-			if slice.Contains(args.UndoablePerennialCommits, inconsistentlyChangedPerennial.After.LocalSHA) {
+			undoableCommits := args.UndoablePerennialCommits[inconsistentlyChangedPerennial.After.LocalName]
+			if slice.Contains(undoableCommits, inconsistentlyChangedPerennial.After.LocalSHA) {
 				result.Add(&opcodes.Checkout{Branch: inconsistentlyChangedPerennial.Before.LocalName})
-				result.Add(&opcodes.RevertCommit{SHA: inconsistentlyChangedPerennial.After.LocalSHA})
+				revertCommits(&result, undoableCommits)
 				result.Add(&opcodes.PushCurrentBranch{CurrentBranch: inconsistentlyChangedPerennial.After.LocalName})
 			}
 		}
@@ -194,5 +196,13 @@ type BranchChangesUndoProgramArgs struct {
 	BeginBranch              gitdomain.LocalBranchName
 	Config                   *configdomain.FullConfig
 	EndBranch                gitdomain.LocalBranchName
-	UndoablePerennialCommits []gitdomain.SHA
+	UndoablePerennialCommits map[gitdomain.LocalBranchName]gitdomain.SHAs
+}
+
+// revertCommits adds opcodes that revert the given commits to the given program.
+// It reverts newer commits first, for example the squash commits of a shipped stack.
+func revertCommits(prog *program.Program, commits gitdomain.SHAs) {
+	for i := len(commits) - 1; i >= 0; i-- {
+		prog.Add(&opcodes.RevertCommit{SHA: commits[i]})
+	}
 }
@@ -79,7 +79,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("main")},
@@ -133,7 +133,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			&opcodes.CreateBranch{
@@ -222,7 +222,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("feature-branch")},
@@ -312,7 +312,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			&opcodes.DeleteTrackingBranch{
@@ -396,7 +396,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			&opcodes.DeleteLocalBranch{Branch: gitdomain.NewLocalBranchName("perennial-branch")},
@@ -464,7 +464,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			&opcodes.DeleteTrackingBranch{
@@ -557,7 +557,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("feature-branch")},
@@ -653,7 +653,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			// It doesn't reset the remote perennial branch since those are assumed to be protected against force-pushes
@@ -762,8 +762,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch: before.Active,
 			Config:      &config,
 			EndBranch:   after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{
-				gitdomain.NewSHA("444444"),
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{
+				gitdomain.NewLocalBranchName("main"): {gitdomain.NewSHA("444444")},
 			},
 		})
 		wantProgram := program.Program{
@@ -862,8 +862,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch: before.Active,
 			Config:      &config,
 			EndBranch:   after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{
-				gitdomain.NewSHA("444444"),
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{
+				gitdomain.NewLocalBranchName("main"): {gitdomain.NewSHA("444444")},
 			},
 		})
 		wantProgram := program.Program{
@@ -880,6 +880,284 @@ func TestChanges(t *testing.T) {
 		must.Eq(t, wantProgram, haveProgram)
 	})
 
+	t.Run("stack shipped into the main branch", func(t *testing.T) {
+		t.Parallel()
+		before := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("main"),
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  gitdomain.NewSHA("111111"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("alpha"),
+					LocalSHA:   gitdomain.NewSHA("222222"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/alpha"),
+					RemoteSHA:  gitdomain.NewSHA("222222"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("beta"),
+					LocalSHA:   gitdomain.NewSHA("333333"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/beta"),
+					RemoteSHA:  gitdomain.NewSHA("333333"),
+				},
+			},
+			Active: gitdomain.NewLocalBranchName("beta"),
+		}
+		after := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("main"),
+					LocalSHA:   gitdomain.NewSHA("555555"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  gitdomain.NewSHA("555555"),
+				},
+			},
+			Active: gitdomain.NewLocalBranchName("main"),
+		}
+		span := undobranches.NewBranchSpans(before, after)
+		haveChanges := span.Changes()
+		wantChanges := undobranches.BranchChanges{
+			LocalAdded:    gitdomain.LocalBranchNames{},
+			LocalRemoved:  undobranches.LocalBranchesSHAs{},
+			LocalChanged:  undobranches.LocalBranchChange{},
+			RemoteAdded:   gitdomain.RemoteBranchNames{},
+			RemoteRemoved: undobranches.RemoteBranchesSHAs{},
+			RemoteChanged: map[gitdomain.RemoteBranchName]undodomain.Change[gitdomain.SHA]{},
+			OmniRemoved: undobranches.LocalBranchesSHAs{
+				gitdomain.NewLocalBranchName("alpha"): gitdomain.NewSHA("222222"),
+				gitdomain.NewLocalBranchName("beta"):  gitdomain.NewSHA("333333"),
+			},
+			OmniChanged: undobranches.LocalBranchChange{
+				gitdomain.NewLocalBranchName("main"): {
+					Before: gitdomain.NewSHA("111111"),
+					After:  gitdomain.NewSHA("555555"),
+				},
+			},
+			InconsistentlyChanged: undodomain.InconsistentChanges{},
+		}
+		must.Eq(t, wantChanges, haveChanges)
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			Lineage: configdomain.Lineage{
+				gitdomain.NewLocalBranchName("alpha"): gitdomain.NewLocalBranchName("main"),
+				gitdomain.NewLocalBranchName("beta"):  gitdomain.NewLocalBranchName("alpha"),
+			},
+			MainBranch:        gitdomain.NewLocalBranchName("main"),
+			PerennialBranches: gitdomain.NewLocalBranchNames(),
+			PushHook:          false,
+		}
+		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
+			BeginBranch: before.Active,
+			Config:      &config,
+			EndBranch:   after.Active,
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{
+				gitdomain.NewLocalBranchName("main"): {gitdomain.NewSHA("444444"), gitdomain.NewSHA("555555")},
+			},
+		})
+		wantProgram := program.Program{
+			// revert the squash commits on the main branch, newest first
+			&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("main")},
+			&opcodes.RevertCommit{SHA: gitdomain.NewSHA("555555")},
+			&opcodes.RevertCommit{SHA: gitdomain.NewSHA("444444")},
+			&opcodes.PushCurrentBranch{CurrentBranch: gitdomain.NewLocalBranchName("main")},
+			// re-create the shipped branches
+			&opcodes.CreateBranch{Branch: gitdomain.NewLocalBranchName("alpha"), StartingPoint: gitdomain.NewSHA("222222").Location()},
+			&opcodes.CreateTrackingBranch{Branch: gitdomain.NewLocalBranchName("alpha")},
+			&opcodes.CreateBranch{Branch: gitdomain.NewLocalBranchName("beta"), StartingPoint: gitdomain.NewSHA("333333").Location()},
+			&opcodes.CreateTrackingBranch{Branch: gitdomain.NewLocalBranchName("beta")},
+			// check out the initial branch
+			&opcodes.CheckoutIfExists{Branch: gitdomain.NewLocalBranchName("beta")},
+		}
+		must.Eq(t, wantProgram, haveProgram)
+	})
+
+	t.Run("undoable commits on several perennial branches", func(t *testing.T) {
+		t.Parallel()
+		before := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("main"),
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  gitdomain.NewSHA("111111"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("production"),
+					LocalSHA:   gitdomain.NewSHA("222222"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/production"),
+					RemoteSHA:  gitdomain.NewSHA("222222"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("qa"),
+					LocalSHA:   gitdomain.NewSHA("333333"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/qa"),
+					RemoteSHA:  gitdomain.NewSHA("333333"),
+				},
+			},
+			Active: gitdomain.NewLocalBranchName("main"),
+		}
+		after := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("main"),
+					LocalSHA:   gitdomain.NewSHA("555555"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  gitdomain.NewSHA("555555"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("production"),
+					LocalSHA:   gitdomain.NewSHA("777777"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/production"),
+					RemoteSHA:  gitdomain.NewSHA("777777"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("qa"),
+					LocalSHA:   gitdomain.NewSHA("333333"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/qa"),
+					RemoteSHA:  gitdomain.NewSHA("333333"),
+				},
+			},
+			Active: gitdomain.NewLocalBranchName("main"),
+		}
+		span := undobranches.NewBranchSpans(before, after)
+		haveChanges := span.Changes()
+		wantChanges := undobranches.BranchChanges{
+			LocalAdded:    gitdomain.LocalBranchNames{},
+			LocalRemoved:  undobranches.LocalBranchesSHAs{},
+			LocalChanged:  undobranches.LocalBranchChange{},
+			RemoteAdded:   gitdomain.RemoteBranchNames{},
+			RemoteRemoved: undobranches.RemoteBranchesSHAs{},
+			RemoteChanged: map[gitdomain.RemoteBranchName]undodomain.Change[gitdomain.SHA]{},
+			OmniRemoved:   undobranches.LocalBranchesSHAs{},
+			OmniChanged: undobranches.LocalBranchChange{
+				gitdomain.NewLocalBranchName("main"): {
+					Before: gitdomain.NewSHA("111111"),
+					After:  gitdomain.NewSHA("555555"),
+				},
+				gitdomain.NewLocalBranchName("production"): {
+					Before: gitdomain.NewSHA("222222"),
+					After:  gitdomain.NewSHA("777777"),
+				},
+			},
+			InconsistentlyChanged: undodomain.InconsistentChanges{},
+		}
+		must.Eq(t, wantChanges, haveChanges)
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			Lineage:           configdomain.Lineage{},
+			MainBranch:        gitdomain.NewLocalBranchName("main"),
+			PerennialBranches: gitdomain.NewLocalBranchNames("production", "qa"),
+			PushHook:          false,
+		}
+		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
+			BeginBranch: before.Active,
+			Config:      &config,
+			EndBranch:   after.Active,
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{
+				gitdomain.NewLocalBranchName("main"):       {gitdomain.NewSHA("444444"), gitdomain.NewSHA("555555")},
+				gitdomain.NewLocalBranchName("production"): {gitdomain.NewSHA("666666"), gitdomain.NewSHA("777777")},
+				gitdomain.NewLocalBranchName("qa"):         {gitdomain.NewSHA("888888")},
+			},
+		})
+		wantProgram := program.Program{
+			// revert only the commits made on the main branch
+			&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("main")},
+			&opcodes.RevertCommit{SHA: gitdomain.NewSHA("555555")},
+			&opcodes.RevertCommit{SHA: gitdomain.NewSHA("444444")},
+			&opcodes.PushCurrentBranch{CurrentBranch: gitdomain.NewLocalBranchName("main")},
+			// revert only the commits made on the production branch
+			&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("production")},
+			&opcodes.RevertCommit{SHA: gitdomain.NewSHA("777777")},
+			&opcodes.RevertCommit{SHA: gitdomain.NewSHA("666666")},
+			&opcodes.PushCurrentBranch{CurrentBranch: gitdomain.NewLocalBranchName("production")},
+			// check out the initial branch
+			&opcodes.CheckoutIfExists{Branch: gitdomain.NewLocalBranchName("main")},
+		}
+		must.Eq(t, wantProgram, haveProgram)
+	})
+
+	t.Run("perennial branch with several undoable commits pulled and changed", func(t *testing.T) {
+		t.Parallel()
+		before := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("main"),
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusNotInSync,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  gitdomain.NewSHA("222222"),
+				},
+			},
+			Active: gitdomain.NewLocalBranchName("main"),
+		}
+		after := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("main"),
+					LocalSHA:   gitdomain.NewSHA("444444"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  gitdomain.NewSHA("444444"),
+				},
+			},
+			Active: gitdomain.NewLocalBranchName("main"),
+		}
+		span := undobranches.NewBranchSpans(before, after)
+		haveChanges := span.Changes()
+		wantChanges := undobranches.BranchChanges{
+			LocalAdded:    gitdomain.LocalBranchNames{},
+			LocalRemoved:  undobranches.LocalBranchesSHAs{},
+			LocalChanged:  undobranches.LocalBranchChange{},
+			RemoteAdded:   gitdomain.RemoteBranchNames{},
+			RemoteRemoved: undobranches.RemoteBranchesSHAs{},
+			RemoteChanged: map[gitdomain.RemoteBranchName]undodomain.Change[gitdomain.SHA]{},
+			OmniRemoved:   undobranches.LocalBranchesSHAs{},
+			OmniChanged:   undobranches.LocalBranchChange{},
+			InconsistentlyChanged: undodomain.InconsistentChanges{
+				undodomain.InconsistentChange{
+					Before: before.Branches[0],
+					After:  after.Branches[0],
+				},
+			},
+		}
+		must.Eq(t, wantChanges, haveChanges)
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			Lineage:           configdomain.Lineage{},
+			MainBranch:        gitdomain.NewLocalBranchName("main"),
+			PerennialBranches: gitdomain.NewLocalBranchNames("production"),
+			PushHook:          false,
+		}
+		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
+			BeginBranch: before.Active,
+			Config:      &config,
+			EndBranch:   after.Active,
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{
+				gitdomain.NewLocalBranchName("main"):       {gitdomain.NewSHA("333333"), gitdomain.NewSHA("444444")},
+				gitdomain.NewLocalBranchName("production"): {gitdomain.NewSHA("555555")},
+			},
+		})
+		wantProgram := program.Program{
+			// revert only the commits made on the main branch, newest first
+			&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("main")},
+			&opcodes.RevertCommit{SHA: gitdomain.NewSHA("444444")},
+			&opcodes.RevertCommit{SHA: gitdomain.NewSHA("333333")},
+			&opcodes.PushCurrentBranch{CurrentBranch: gitdomain.NewLocalBranchName("main")},
+			// check out the initial branch
+			&opcodes.CheckoutIfExists{Branch: gitdomain.NewLocalBranchName("main")},
+		}
+		must.Eq(t, wantProgram, haveProgram)
+	})
+
 	t.Run("omnibranch changed locally and remotely to different SHAs", func(t *testing.T) {
 		t.Parallel()
 		before := gitdomain.BranchesSnapshot{
@@ -980,7 +1258,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			// It doesn't revert the perennial branch because it cannot force-push the changes to the remote branch.
@@ -1076,7 +1354,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("feature-branch")},
@@ -1172,7 +1450,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			// It doesn't revert the remote perennial branch because it cannot force-push the changes to it.
@@ -1256,7 +1534,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			&opcodes.CreateBranch{
@@ -1342,7 +1620,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			// don't re-create the tracking branch for the perennial branch
@@ -1423,7 +1701,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		})
 		wantProgram := program.Program{
 			// No changes should happen here since all changes were syncs on perennial branches.
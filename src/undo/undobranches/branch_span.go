@@ -50,3 +50,16 @@ func (self BranchSpan) RemoteChanged() bool {
 func (self BranchSpan) RemoteRemoved() bool {
 	return self.Before.HasRemoteBranch() && !self.After.HasRemoteBranch()
 }
+
+// BranchName provides the local name of the branch that this BranchSpan describes.
+func (self BranchSpan) BranchName() gitdomain.LocalBranchName {
+	for _, branchInfo := range []gitdomain.BranchInfo{self.Before, self.After} {
+		if branchInfo.HasLocalBranch() {
+			return branchInfo.LocalName
+		}
+		if branchInfo.HasRemoteBranch() {
+			return branchInfo.RemoteName.LocalBranchName()
+		}
+	}
+	return gitdomain.EmptyLocalBranchName()
+}
@@ -646,4 +646,36 @@ func TestBranchSpan(t *testing.T) {
 			must.False(t, bs.RemoteRemoved())
 		})
 	})
+
+	t.Run("BranchName", func(t *testing.T) {
+		t.Parallel()
+		t.Run("local branch", func(t *testing.T) {
+			t.Parallel()
+			bs := undobranches.BranchSpan{
+				Before: gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-1"),
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/branch-1"),
+					RemoteSHA:  gitdomain.NewSHA("111111"),
+				},
+				After: gitdomain.EmptyBranchInfo(),
+			}
+			must.EqOp(t, gitdomain.NewLocalBranchName("branch-1"), bs.BranchName())
+		})
+		t.Run("remote branch added", func(t *testing.T) {
+			t.Parallel()
+			bs := undobranches.BranchSpan{
+				Before: gitdomain.EmptyBranchInfo(),
+				After: gitdomain.BranchInfo{
+					LocalName:  gitdomain.EmptyLocalBranchName(),
+					LocalSHA:   gitdomain.EmptySHA(),
+					SyncStatus: gitdomain.SyncStatusRemoteOnly,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/branch-1"),
+					RemoteSHA:  gitdomain.NewSHA("111111"),
+				},
+			}
+			must.EqOp(t, gitdomain.NewLocalBranchName("branch-1"), bs.BranchName())
+		})
+	})
 }
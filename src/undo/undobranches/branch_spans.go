@@ -78,3 +78,15 @@ func (self BranchSpans) Changes() BranchChanges {
 	}
 	return result
 }
+
+// ChangedBranches provides the names of the branches that this BranchSpans changes.
+func (self BranchSpans) ChangedBranches() gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, branchSpan := range self {
+		if branchSpan.NoChanges() {
+			continue
+		}
+		result = result.AppendAllMissing(branchSpan.BranchName())
+	}
+	return result
+}
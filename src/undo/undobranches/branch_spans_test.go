@@ -1,8 +1,66 @@
 package undobranches_test
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/undo/undobranches"
+	"github.com/shoenig/test/must"
+)
 
 func TestBranchSpans(t *testing.T) {
 	t.Parallel()
 	// BranchSpans are testing in branch_changes_test.go.
+
+	t.Run("ChangedBranches", func(t *testing.T) {
+		t.Parallel()
+		before := gitdomain.BranchesSnapshot{
+			Active: gitdomain.NewLocalBranchName("branch-1"),
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-1"),
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/branch-1"),
+					RemoteSHA:  gitdomain.NewSHA("111111"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-2"),
+					LocalSHA:   gitdomain.NewSHA("222222"),
+					SyncStatus: gitdomain.SyncStatusLocalOnly,
+					RemoteName: gitdomain.EmptyRemoteBranchName(),
+					RemoteSHA:  gitdomain.EmptySHA(),
+				},
+			},
+		}
+		after := gitdomain.BranchesSnapshot{
+			Active: gitdomain.NewLocalBranchName("branch-1"),
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-1"),
+					LocalSHA:   gitdomain.NewSHA("333333"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/branch-1"),
+					RemoteSHA:  gitdomain.NewSHA("333333"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-2"),
+					LocalSHA:   gitdomain.NewSHA("222222"),
+					SyncStatus: gitdomain.SyncStatusLocalOnly,
+					RemoteName: gitdomain.EmptyRemoteBranchName(),
+					RemoteSHA:  gitdomain.EmptySHA(),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-3"),
+					LocalSHA:   gitdomain.NewSHA("444444"),
+					SyncStatus: gitdomain.SyncStatusLocalOnly,
+					RemoteName: gitdomain.EmptyRemoteBranchName(),
+					RemoteSHA:  gitdomain.EmptySHA(),
+				},
+			},
+		}
+		have := undobranches.NewBranchSpans(before, after).ChangedBranches()
+		want := gitdomain.NewLocalBranchNames("branch-1", "branch-3")
+		must.Eq(t, want, have)
+	})
 }
@@ -11,3 +11,8 @@ type ConfigDiff struct {
 	Changed map[gitconfig.Key]undodomain.Change[string]
 	Removed map[gitconfig.Key]string
 }
+
+// IsEmpty indicates whether this ConfigDiff contains no changes.
+func (self ConfigDiff) IsEmpty() bool {
+	return len(self.Added) == 0 && len(self.Changed) == 0 && len(self.Removed) == 0
+}
@@ -18,6 +18,11 @@ func NewConfigDiffs(before, after ConfigSnapshot) ConfigDiffs {
 	}
 }
 
+// IsEmpty indicates whether these ConfigDiffs contain no changes.
+func (self ConfigDiffs) IsEmpty() bool {
+	return self.Global.IsEmpty() && self.Local.IsEmpty()
+}
+
 func (self ConfigDiffs) UndoProgram() program.Program {
 	result := program.Program{}
 	for _, key := range self.Global.Added {
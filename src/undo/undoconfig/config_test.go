@@ -14,6 +14,21 @@ import (
 func TestConfigUndo(t *testing.T) {
 	t.Parallel()
 
+	t.Run("no changes", func(t *testing.T) {
+		t.Parallel()
+		snapshot := undoconfig.ConfigSnapshot{
+			Global: gitconfig.SingleSnapshot{
+				gitconfig.KeyOffline: "0",
+			},
+			Local: gitconfig.SingleSnapshot{
+				gitconfig.KeyPerennialBranches: "prod",
+			},
+		}
+		haveDiff := undoconfig.NewConfigDiffs(snapshot, snapshot)
+		must.True(t, haveDiff.IsEmpty())
+		must.Eq(t, program.Program{}, haveDiff.UndoProgram())
+	})
+
 	t.Run("adding a value to the global cache", func(t *testing.T) {
 		t.Parallel()
 		before := undoconfig.ConfigSnapshot{
@@ -41,6 +56,7 @@ func TestConfigUndo(t *testing.T) {
 			Local: emptyConfigDiff(),
 		}
 		must.Eq(t, wantDiff, haveDiff)
+		must.False(t, haveDiff.IsEmpty())
 		haveProgram := haveDiff.UndoProgram()
 		wantProgram := program.Program{
 			&opcodes.RemoveGlobalConfig{
@@ -1,3 +1,18 @@
 package undodomain
 
+import "github.com/git-town/git-town/v12/src/git/gitdomain"
+
 type InconsistentChanges []InconsistentChange
+
+// BranchNames provides the names of the local branches that these InconsistentChanges affect.
+func (self InconsistentChanges) BranchNames() gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, change := range self {
+		name := change.Before.LocalName
+		if name.IsEmpty() {
+			name = change.After.LocalName
+		}
+		result = result.AppendAllMissing(name)
+	}
+	return result
+}
@@ -2,8 +2,47 @@ package undodomain_test
 
 import (
 	"testing"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/undo/undodomain"
+	"github.com/shoenig/test/must"
 )
 
-func TestInconsistentChange(t *testing.T) {
+func TestInconsistentChanges(t *testing.T) {
 	t.Parallel()
+
+	t.Run("BranchNames", func(t *testing.T) {
+		t.Parallel()
+		changes := undodomain.InconsistentChanges{
+			{
+				Before: gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-1"),
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/branch-1"),
+					RemoteSHA:  gitdomain.NewSHA("111111"),
+				},
+				After: gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-1"),
+					LocalSHA:   gitdomain.NewSHA("222222"),
+					SyncStatus: gitdomain.SyncStatusNotInSync,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/branch-1"),
+					RemoteSHA:  gitdomain.NewSHA("333333"),
+				},
+			},
+			{
+				Before: gitdomain.EmptyBranchInfo(),
+				After: gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-2"),
+					LocalSHA:   gitdomain.NewSHA("444444"),
+					SyncStatus: gitdomain.SyncStatusLocalOnly,
+					RemoteName: gitdomain.EmptyRemoteBranchName(),
+					RemoteSHA:  gitdomain.EmptySHA(),
+				},
+			},
+		}
+		have := changes.BranchNames()
+		want := gitdomain.NewLocalBranchNames("branch-1", "branch-2")
+		must.Eq(t, want, have)
+	})
 }
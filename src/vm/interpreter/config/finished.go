@@ -41,11 +41,19 @@ func Finished(args FinishedArgs) error {
 		FinalUndoProgram:         program.Program{},
 		IsUndo:                   false,
 		RunProgram:               program.Program{},
-		UndoablePerennialCommits: gitdomain.SHAs{},
+		UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		UnfinishedDetails:        nil,
 	}
 	print.Footer(args.Verbose, args.Runner.CommandsCounter.Count(), args.Runner.FinalMessages.Result())
-	return statefile.Save(&runState, args.RootDir)
+	err = statefile.Save(&runState, args.RootDir)
+	if err != nil {
+		return err
+	}
+	err = statefile.AppendHistory(&runState, args.RootDir)
+	if err != nil {
+		return err
+	}
+	return statefile.DeleteRedo(args.RootDir)
 }
 
 type FinishedArgs struct {
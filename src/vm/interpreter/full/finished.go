@@ -45,6 +45,15 @@ func finished(args ExecuteArgs) error {
 	if err != nil {
 		return fmt.Errorf(messages.RunstateSaveProblem, err)
 	}
+	err = statefile.AppendHistory(args.RunState, args.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSaveProblem, err)
+	}
+	// a new command makes the most recent undo impossible to redo
+	err = statefile.DeleteRedo(args.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateDeleteProblem, err)
+	}
 	print.Footer(args.Verbose, args.Run.CommandsCounter.Count(), args.Run.FinalMessages.Result())
 	return nil
 }
@@ -0,0 +1,15 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// CommitSquashedChanges commits the staged changes of a compressed branch as a single new commit.
+type CommitSquashedChanges struct {
+	Message string
+	undeclaredOpcodeMethods
+}
+
+func (self *CommitSquashedChanges) Run(args shared.RunArgs) error {
+	return args.Runner.Frontend.CommitStagedChanges(self.Message)
+}
@@ -0,0 +1,26 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// ConnectorCloseProposal closes the proposal with the given number at the code hosting platform without merging it.
+type ConnectorCloseProposal struct {
+	ProposalNumber int
+	undeclaredOpcodeMethods
+}
+
+func (self *ConnectorCloseProposal) CreateAutomaticUndoError() error {
+	return fmt.Errorf(messages.ProposalCloseProblem, self.ProposalNumber)
+}
+
+func (self *ConnectorCloseProposal) Run(args shared.RunArgs) error {
+	return args.Connector.CloseProposal(self.ProposalNumber)
+}
+
+func (self *ConnectorCloseProposal) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
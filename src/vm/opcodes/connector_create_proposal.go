@@ -0,0 +1,41 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// ConnectorCreateProposal creates a proposal for the branch with the given name
+// via the API of the code hosting platform.
+type ConnectorCreateProposal struct {
+	Body   string
+	Branch gitdomain.LocalBranchName
+	Draft  bool
+	Title  string
+	undeclaredOpcodeMethods
+}
+
+func (self *ConnectorCreateProposal) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		self,
+	}
+}
+
+func (self *ConnectorCreateProposal) Run(args shared.RunArgs) error {
+	if args.Runner.Config.DryRun {
+		print.Logger{}.Start(messages.ProposalCreateDryRun, self.Branch)
+		fmt.Println("(dry run)")
+		return nil
+	}
+	parentBranch := args.Lineage.Parent(self.Branch)
+	proposal, err := args.Connector.CreateProposal(self.Branch, parentBranch, self.Title, self.Body, self.Draft)
+	if err != nil {
+		return err
+	}
+	args.Runner.FinalMessages.Add(fmt.Sprintf(messages.ProposalCreated, proposal.URL))
+	return nil
+}
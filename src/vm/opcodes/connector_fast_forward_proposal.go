@@ -0,0 +1,28 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// ConnectorFastForwardProposal merges the proposal for the branch with the given name
+// via the API of the code hosting platform without creating a merge commit.
+type ConnectorFastForwardProposal struct {
+	Branch         gitdomain.LocalBranchName
+	ProposalNumber int
+	mergeError     error
+	undeclaredOpcodeMethods
+}
+
+func (self *ConnectorFastForwardProposal) CreateAutomaticUndoError() error {
+	return self.mergeError
+}
+
+func (self *ConnectorFastForwardProposal) Run(args shared.RunArgs) error {
+	self.mergeError = args.Connector.FastForwardProposal(self.ProposalNumber)
+	return self.mergeError
+}
+
+func (self *ConnectorFastForwardProposal) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
@@ -0,0 +1,30 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// ConnectorMergeCommitProposal merges the proposal for the branch with the given name
+// via the API of the code hosting platform using a merge commit.
+// Uses the default commit message of the code hosting platform if no commit message is given.
+type ConnectorMergeCommitProposal struct {
+	Branch         gitdomain.LocalBranchName
+	CommitMessage  string
+	ProposalNumber int
+	mergeError     error
+	undeclaredOpcodeMethods
+}
+
+func (self *ConnectorMergeCommitProposal) CreateAutomaticUndoError() error {
+	return self.mergeError
+}
+
+func (self *ConnectorMergeCommitProposal) Run(args shared.RunArgs) error {
+	self.mergeError = args.Connector.MergeCommitProposal(self.ProposalNumber, self.CommitMessage)
+	return self.mergeError
+}
+
+func (self *ConnectorMergeCommitProposal) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
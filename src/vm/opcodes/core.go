@@ -48,17 +48,24 @@ func Types() []shared.Opcode {
 		&AbortMerge{},
 		&AbortRebase{},
 		&AddToPerennialBranches{},
+		&AddToPrototypeBranches{},
 		&ChangeParent{},
 		&Checkout{},
 		&CheckoutIfExists{},
 		&CheckoutParent{},
 		&ChangeParent{},
 		&CommitOpenChanges{},
+		&CommitSquashedChanges{},
+		&ConnectorCloseProposal{},
+		&ConnectorCreateProposal{},
+		&ConnectorFastForwardProposal{},
+		&ConnectorMergeCommitProposal{},
 		&ConnectorMergeProposal{},
 		&ContinueMerge{},
 		&ContinueRebase{},
 		&CreateBranch{},
 		&CreateBranchExistingParent{},
+		&CreateMergeCommit{},
 		&CreateProposal{},
 		&CreateRemoteBranch{},
 		&CreateTrackingBranch{},
@@ -68,6 +75,8 @@ func Types() []shared.Opcode {
 		&DiscardOpenChanges{},
 		&EndOfBranchProgram{},
 		&EnsureHasShippableChanges{},
+		&ExecuteShellCommand{},
+		&FastForwardMerge{},
 		&FetchUpstream{},
 		&ForcePushCurrentBranch{},
 		&DeleteBranchIfEmptyAtRuntime{},
@@ -77,13 +86,17 @@ func Types() []shared.Opcode {
 		&PullCurrentBranch{},
 		&PushCurrentBranch{},
 		&PushTags{},
+		&QueueMessage{},
 		&RebaseBranch{},
 		&RebaseFeatureTrackingBranch{},
+		&RebaseOnto{},
 		&RebaseParent{},
 		&RemoveBranchFromLineage{},
 		&RemoveFromPerennialBranches{},
+		&RemoveFromPrototypeBranches{},
 		&RemoveGlobalConfig{},
 		&RemoveLocalConfig{},
+		&ResetCommitsInCurrentBranch{},
 		&ResetCurrentBranchToSHA{},
 		&ResetRemoteBranchToSHA{},
 		&RestoreOpenChanges{},
@@ -97,6 +110,7 @@ func Types() []shared.Opcode {
 		&StashOpenChanges{},
 		&SquashMerge{},
 		&UndoLastCommit{},
+		&UpdateProposalStacks{},
 		&UpdateProposalTarget{},
 	}
 }
@@ -0,0 +1,48 @@
+package opcodes
+
+import (
+	"errors"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// CreateMergeCommit merges the branch with the given name into the current branch,
+// always creating a merge commit.
+type CreateMergeCommit struct {
+	Branch        gitdomain.LocalBranchName
+	CommitMessage string
+	undeclaredOpcodeMethods
+}
+
+func (self *CreateMergeCommit) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&AbortMerge{},
+	}
+}
+
+func (self *CreateMergeCommit) CreateAutomaticUndoError() error {
+	return errors.New(messages.ShipAbortedMergeError)
+}
+
+func (self *CreateMergeCommit) Run(args shared.RunArgs) error {
+	err := args.Runner.Frontend.MergeNoFastForward(self.Branch, self.CommitMessage)
+	if err != nil {
+		return err
+	}
+	currentBranch, err := args.Runner.Backend.CurrentBranch()
+	if err != nil {
+		return err
+	}
+	mergeCommitSHA, err := args.Runner.Backend.SHAForBranch(currentBranch.BranchName())
+	if err != nil {
+		return err
+	}
+	args.RegisterUndoablePerennialCommit(currentBranch, mergeCommitSHA)
+	return nil
+}
+
+func (self *CreateMergeCommit) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
@@ -0,0 +1,31 @@
+package opcodes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// ExecuteShellCommand runs the given shell command on the current branch.
+type ExecuteShellCommand struct {
+	Args       []string
+	Executable string
+	undeclaredOpcodeMethods
+}
+
+func (self *ExecuteShellCommand) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		self,
+	}
+}
+
+func (self *ExecuteShellCommand) Run(args shared.RunArgs) error {
+	err := args.Runner.Frontend.ExecuteShellCommand(self.Executable, self.Args)
+	if err != nil {
+		command := strings.Join(append([]string{self.Executable}, self.Args...), " ")
+		return fmt.Errorf(messages.WalkCommandFailed, command, err)
+	}
+	return nil
+}
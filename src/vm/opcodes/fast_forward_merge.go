@@ -0,0 +1,27 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// FastForwardMerge fast-forwards the current branch to the branch with the given name.
+type FastForwardMerge struct {
+	Branch gitdomain.LocalBranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *FastForwardMerge) CreateAutomaticUndoError() error {
+	return fmt.Errorf(messages.ShipFastForwardFailed, self.Branch)
+}
+
+func (self *FastForwardMerge) Run(args shared.RunArgs) error {
+	return args.Runner.Frontend.FastForwardMerge(self.Branch)
+}
+
+func (self *FastForwardMerge) ShouldAutomaticallyUndoOnError() bool {
+	return true
+}
@@ -0,0 +1,28 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// RebaseOnto moves the commits of the current branch that come after the given location
+// on top of the given branch.
+type RebaseOnto struct {
+	BranchToRebaseAgainst gitdomain.BranchName
+	CommitsToRemove       gitdomain.Location
+	undeclaredOpcodeMethods
+}
+
+func (self *RebaseOnto) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{&AbortRebase{}}
+}
+
+func (self *RebaseOnto) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ContinueRebase{},
+	}
+}
+
+func (self *RebaseOnto) Run(args shared.RunArgs) error {
+	return args.Runner.Frontend.RebaseOnto(self.BranchToRebaseAgainst, self.CommitsToRemove)
+}
@@ -0,0 +1,17 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// ResetCommitsInCurrentBranch removes all commits of the current branch that aren't in its parent branch,
+// keeping their changes staged.
+type ResetCommitsInCurrentBranch struct {
+	Parent gitdomain.LocalBranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *ResetCommitsInCurrentBranch) Run(args shared.RunArgs) error {
+	return args.Runner.Frontend.ResetCurrentBranchToParent(self.Parent)
+}
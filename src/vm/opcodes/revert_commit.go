@@ -29,5 +29,12 @@ func (self *RevertCommit) Run(args shared.RunArgs) error {
 	if !slice.Contains(commitsInCurrentBranch, self.SHA) {
 		return fmt.Errorf(messages.BranchDoesntContainCommit, currentBranch, self.SHA, commitsInCurrentBranch.Join("|"))
 	}
+	isMergeCommit, err := args.Runner.Backend.IsMergeCommit(self.SHA)
+	if err != nil {
+		return err
+	}
+	if isMergeCommit {
+		return args.Runner.Frontend.RevertMergeCommit(self.SHA)
+	}
 	return args.Runner.Frontend.RevertCommit(self.SHA)
 }
@@ -64,7 +64,7 @@ func (self *SquashMerge) Run(args shared.RunArgs) error {
 	if err != nil {
 		return err
 	}
-	args.RegisterUndoablePerennialCommit(squashedCommitSHA)
+	args.RegisterUndoablePerennialCommit(self.Parent, squashedCommitSHA)
 	return nil
 }
 
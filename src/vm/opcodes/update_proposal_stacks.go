@@ -0,0 +1,22 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// UpdateProposalStacks updates the stack tables in the bodies of the proposals
+// in the stacks of the given branches at the code hosting platform.
+type UpdateProposalStacks struct {
+	Branches gitdomain.LocalBranchNames
+	undeclaredOpcodeMethods
+}
+
+func (self *UpdateProposalStacks) Run(args shared.RunArgs) error {
+	return hosting.UpdateProposalStacks(hosting.UpdateProposalStacksArgs{
+		Branches:  self.Branches,
+		Config:    &args.Runner.Config.FullConfig,
+		Connector: args.Connector,
+	})
+}
@@ -3,6 +3,7 @@ package opcodes
 import (
 	"fmt"
 
+	"github.com/git-town/git-town/v12/src/cli/print"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/git-town/git-town/v12/src/vm/shared"
@@ -20,6 +21,11 @@ func (self *UpdateProposalTarget) CreateAutomaticUndoError() error {
 }
 
 func (self *UpdateProposalTarget) Run(args shared.RunArgs) error {
+	if args.Runner.Config.DryRun {
+		print.Logger{}.Start(messages.ProposalTargetUpdateDryRun, self.ProposalNumber, self.NewTarget)
+		fmt.Println("(dry run)")
+		return nil
+	}
 	return args.Connector.UpdateProposalTarget(self.ProposalNumber, self.NewTarget)
 }
 
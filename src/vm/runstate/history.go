@@ -0,0 +1,42 @@
+package runstate
+
+import "time"
+
+// HistoryLength defines how many finished Git Town commands the history of a repo contains at most.
+const HistoryLength = 20
+
+// History contains the most recent finished Git Town commands in a repo, oldest first.
+type History []HistoryEntry
+
+// Add provides a copy of this history with the given entry appended,
+// dropping the oldest entries so that the result contains at most HistoryLength entries.
+func (self History) Add(entry HistoryEntry) History {
+	result := append(History{}, self...)
+	result = append(result, entry)
+	if len(result) > HistoryLength {
+		result = result[len(result)-HistoryLength:]
+	}
+	return result
+}
+
+// Last provides the given number of most recent entries in this history, most recent first.
+func (self History) Last(count int) History {
+	count = min(count, len(self))
+	result := make(History, 0, count)
+	for i := len(self) - 1; i >= len(self)-count; i-- {
+		result = append(result, self[i])
+	}
+	return result
+}
+
+// RemoveLast provides a copy of this history without the given number of most recent entries.
+func (self History) RemoveLast(count int) History {
+	count = min(count, len(self))
+	return append(History{}, self[:len(self)-count]...)
+}
+
+// HistoryEntry describes a finished Git Town command.
+type HistoryEntry struct {
+	EndTime  time.Time
+	RunState RunState
+}
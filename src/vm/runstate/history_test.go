@@ -0,0 +1,84 @@
+package runstate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+
+	newEntry := func(command string) runstate.HistoryEntry {
+		runState := runstate.EmptyRunState()
+		runState.Command = command
+		return runstate.HistoryEntry{
+			EndTime:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			RunState: runState,
+		}
+	}
+
+	commands := func(history runstate.History) []string {
+		result := []string{}
+		for _, entry := range history {
+			result = append(result, entry.RunState.Command)
+		}
+		return result
+	}
+
+	t.Run("Add", func(t *testing.T) {
+		t.Parallel()
+		t.Run("appends the given entry", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{newEntry("hack")}
+			have := history.Add(newEntry("sync"))
+			must.Eq(t, []string{"hack", "sync"}, commands(have))
+			must.Eq(t, []string{"hack"}, commands(history))
+		})
+		t.Run("drops the oldest entries when full", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{}
+			for i := 0; i < runstate.HistoryLength; i++ {
+				history = history.Add(newEntry("hack"))
+			}
+			have := history.Add(newEntry("sync"))
+			must.Len(t, runstate.HistoryLength, have)
+			must.EqOp(t, "sync", have[runstate.HistoryLength-1].RunState.Command)
+		})
+	})
+
+	t.Run("Last", func(t *testing.T) {
+		t.Parallel()
+		t.Run("provides the most recent entries first", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{newEntry("hack"), newEntry("sync"), newEntry("ship")}
+			have := history.Last(2)
+			must.Eq(t, []string{"ship", "sync"}, commands(have))
+		})
+		t.Run("more entries than exist", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{newEntry("hack"), newEntry("sync")}
+			have := history.Last(3)
+			must.Eq(t, []string{"sync", "hack"}, commands(have))
+		})
+	})
+
+	t.Run("RemoveLast", func(t *testing.T) {
+		t.Parallel()
+		t.Run("removes the most recent entries", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{newEntry("hack"), newEntry("sync"), newEntry("ship")}
+			have := history.RemoveLast(2)
+			must.Eq(t, []string{"hack"}, commands(have))
+			must.Len(t, 3, history)
+		})
+		t.Run("more entries than exist", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{newEntry("hack")}
+			have := history.RemoveLast(2)
+			must.Len(t, 0, have)
+		})
+	})
+}
@@ -29,7 +29,7 @@ type RunState struct {
 	FinalUndoProgram         program.Program `exhaustruct:"optional"`
 	IsUndo                   bool            `exhaustruct:"optional"` // TODO: remove?
 	RunProgram               program.Program
-	UndoablePerennialCommits []gitdomain.SHA            `exhaustruct:"optional"`
+	UndoablePerennialCommits UndoablePerennialCommits   `exhaustruct:"optional"`
 	UnfinishedDetails        *UnfinishedRunStateDetails `exhaustruct:"optional"`
 }
 
@@ -90,10 +90,13 @@ func (self *RunState) MarkAsUnfinished(backend *git.BackendCommands) error {
 	return nil
 }
 
-// RegisterUndoablePerennialCommit stores the given commit on a perennial branch as undoable.
+// RegisterUndoablePerennialCommit stores the given commit on the given perennial branch as undoable.
 // This method is used as a callback.
-func (self *RunState) RegisterUndoablePerennialCommit(commit gitdomain.SHA) {
-	self.UndoablePerennialCommits = append(self.UndoablePerennialCommits, commit)
+func (self *RunState) RegisterUndoablePerennialCommit(branch gitdomain.LocalBranchName, commit gitdomain.SHA) {
+	if self.UndoablePerennialCommits == nil {
+		self.UndoablePerennialCommits = UndoablePerennialCommits{}
+	}
+	self.UndoablePerennialCommits[branch] = append(self.UndoablePerennialCommits[branch], commit)
 }
 
 // SkipCurrentBranchProgram removes the opcodes for the current branch
@@ -58,7 +58,7 @@ func TestRunState(t *testing.T) {
 			BeginBranchesSnapshot:    gitdomain.EmptyBranchesSnapshot(),
 			BeginConfigSnapshot:      undoconfig.EmptyConfigSnapshot(),
 			BeginStashSize:           0,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		}
 		encoded, err := json.MarshalIndent(runState, "", "  ")
 		must.NoError(t, err)
@@ -121,7 +121,7 @@ func TestRunState(t *testing.T) {
       "type": "ResetCurrentBranchToSHA"
     }
   ],
-  "UndoablePerennialCommits": [],
+  "UndoablePerennialCommits": {},
   "UnfinishedDetails": null
 }`[1:]
 		must.EqOp(t, want, string(encoded))
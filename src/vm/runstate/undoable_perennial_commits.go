@@ -0,0 +1,28 @@
+package runstate
+
+import (
+	"encoding/json"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// UndoablePerennialCommits contains the commits that Git Town made on perennial branches,
+// by the branch they were made on.
+type UndoablePerennialCommits map[gitdomain.LocalBranchName]gitdomain.SHAs
+
+// UnmarshalJSON also accepts the plain list of commits that earlier Git Town versions persisted.
+// That format doesn't say which perennial branch the commits were made on,
+// so these commits can't be reverted safely and get discarded.
+func (self *UndoablePerennialCommits) UnmarshalJSON(b []byte) error {
+	var legacy gitdomain.SHAs
+	if err := json.Unmarshal(b, &legacy); err == nil {
+		*self = UndoablePerennialCommits{}
+		return nil
+	}
+	var commits map[gitdomain.LocalBranchName]gitdomain.SHAs
+	if err := json.Unmarshal(b, &commits); err != nil {
+		return err
+	}
+	*self = commits
+	return nil
+}
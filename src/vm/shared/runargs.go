@@ -13,7 +13,7 @@ type RunArgs struct {
 	DialogTestInputs                *components.TestInputs
 	Lineage                         configdomain.Lineage
 	PrependOpcodes                  func(...Opcode)
-	RegisterUndoablePerennialCommit func(gitdomain.SHA)
+	RegisterUndoablePerennialCommit func(gitdomain.LocalBranchName, gitdomain.SHA)
 	Runner                          *git.ProdRunner
 	UpdateInitialBranchLocalSHA     func(gitdomain.LocalBranchName, gitdomain.SHA) error
 }
@@ -14,7 +14,12 @@ func Delete(repoDir gitdomain.RepoRootDir) error {
 	if err != nil {
 		return err
 	}
-	_, err = os.Stat(filename)
+	return deleteFile(filename)
+}
+
+// deleteFile removes the given file from disk if it exists.
+func deleteFile(filename string) error {
+	_, err := os.Stat(filename)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil
@@ -0,0 +1,79 @@
+package statefile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+)
+
+// AppendHistory adds the given finished run state to the history of the given Git repo.
+func AppendHistory(runState *runstate.RunState, repoDir gitdomain.RepoRootDir) error {
+	history, err := LoadHistory(repoDir)
+	if err != nil {
+		return err
+	}
+	history = history.Add(runstate.HistoryEntry{
+		EndTime:  time.Now(),
+		RunState: *runState,
+	})
+	return SaveHistory(history, repoDir)
+}
+
+// HistoryFilePath provides the path of the file that stores the history of the given Git repo.
+func HistoryFilePath(repoDir gitdomain.RepoRootDir) (string, error) {
+	filePath, err := FilePath(repoDir)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(filePath, ".json") + ".history.json", nil
+}
+
+// LoadHistory loads the history of the given Git repo from disk.
+// Provides an empty history if there is none.
+func LoadHistory(repoDir gitdomain.RepoRootDir) (runstate.History, error) {
+	filename, err := HistoryFilePath(repoDir)
+	if err != nil {
+		return runstate.History{}, err
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return runstate.History{}, nil
+		}
+		return runstate.History{}, fmt.Errorf(messages.FileReadProblem, filename, err)
+	}
+	var history runstate.History
+	err = json.Unmarshal(content, &history)
+	if err != nil {
+		return runstate.History{}, fmt.Errorf(messages.FileContentInvalidJSON, filename, err)
+	}
+	return history, nil
+}
+
+// SaveHistory stores the given history of the given Git repo to disk.
+func SaveHistory(history runstate.History, repoDir gitdomain.RepoRootDir) error {
+	content, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSerializeProblem, err)
+	}
+	historyPath, err := HistoryFilePath(repoDir)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(historyPath), 0o700)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(historyPath, content, 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.FileWriteProblem, historyPath, err)
+	}
+	return nil
+}
@@ -16,7 +16,12 @@ func Load(repoDir gitdomain.RepoRootDir) (*runstate.RunState, error) {
 	if err != nil {
 		return nil, err
 	}
-	_, err = os.Stat(filename)
+	return loadRunState(filename)
+}
+
+// loadRunState loads the run state stored in the given file. Can return nil if the file doesn't exist.
+func loadRunState(filename string) (*runstate.RunState, error) {
+	_, err := os.Stat(filename)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil //nolint:nilnil
@@ -0,0 +1,45 @@
+package statefile
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+)
+
+// DeleteRedo removes the stored run state of the most recent undo from disk.
+func DeleteRedo(repoDir gitdomain.RepoRootDir) error {
+	filename, err := RedoFilePath(repoDir)
+	if err != nil {
+		return err
+	}
+	return deleteFile(filename)
+}
+
+// LoadRedo loads the run state of the most recent undo in the given Git repo from disk.
+// Can return nil if there is nothing to redo.
+func LoadRedo(repoDir gitdomain.RepoRootDir) (*runstate.RunState, error) {
+	filename, err := RedoFilePath(repoDir)
+	if err != nil {
+		return nil, err
+	}
+	return loadRunState(filename)
+}
+
+// RedoFilePath provides the path of the file that stores the run state of the most recent undo in the given Git repo.
+func RedoFilePath(repoDir gitdomain.RepoRootDir) (string, error) {
+	filePath, err := FilePath(repoDir)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(filePath, ".json") + ".redo.json", nil
+}
+
+// SaveRedo stores the run state of the most recent undo in the given Git repo to disk.
+func SaveRedo(runState *runstate.RunState, repoDir gitdomain.RepoRootDir) error {
+	filename, err := RedoFilePath(repoDir)
+	if err != nil {
+		return err
+	}
+	return saveRunState(runState, filename)
+}
@@ -13,14 +13,19 @@ import (
 
 // Save stores the given run state for the given Git repo to disk.
 func Save(runState *runstate.RunState, repoDir gitdomain.RepoRootDir) error {
-	content, err := json.MarshalIndent(runState, "", "  ")
-	if err != nil {
-		return fmt.Errorf(messages.RunstateSerializeProblem, err)
-	}
 	persistencePath, err := FilePath(repoDir)
 	if err != nil {
 		return err
 	}
+	return saveRunState(runState, persistencePath)
+}
+
+// saveRunState stores the given run state in the given file.
+func saveRunState(runState *runstate.RunState, persistencePath string) error {
+	content, err := json.MarshalIndent(runState, "", "  ")
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSerializeProblem, err)
+	}
 	persistenceDir := filepath.Dir(persistencePath)
 	err = os.MkdirAll(persistenceDir, 0o700)
 	if err != nil {
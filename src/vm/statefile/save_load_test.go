@@ -50,12 +50,31 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.AbortMerge{},
 				&opcodes.AbortRebase{},
 				&opcodes.AddToPerennialBranches{Branch: gitdomain.NewLocalBranchName("branch")},
+				&opcodes.AddToPrototypeBranches{Branch: gitdomain.NewLocalBranchName("branch")},
 				&opcodes.ChangeParent{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 					Parent: gitdomain.NewLocalBranchName("parent"),
 				},
 				&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("branch")},
 				&opcodes.CommitOpenChanges{},
+				&opcodes.CommitSquashedChanges{
+					Message: "commit message",
+				},
+				&opcodes.ConnectorCreateProposal{
+					Body:   "proposal body",
+					Branch: gitdomain.NewLocalBranchName("branch"),
+					Draft:  true,
+					Title:  "proposal title",
+				},
+				&opcodes.ConnectorFastForwardProposal{
+					Branch:         gitdomain.NewLocalBranchName("branch"),
+					ProposalNumber: 123,
+				},
+				&opcodes.ConnectorMergeCommitProposal{
+					Branch:         gitdomain.NewLocalBranchName("branch"),
+					CommitMessage:  "commit message",
+					ProposalNumber: 123,
+				},
 				&opcodes.ConnectorMergeProposal{
 					Branch:          gitdomain.NewLocalBranchName("branch"),
 					CommitMessage:   "commit message",
@@ -68,6 +87,10 @@ func TestLoadSave(t *testing.T) {
 					Branch:        gitdomain.NewLocalBranchName("branch"),
 					StartingPoint: gitdomain.NewSHA("123456").Location(),
 				},
+				&opcodes.CreateMergeCommit{
+					Branch:        gitdomain.NewLocalBranchName("branch"),
+					CommitMessage: "commit message",
+				},
 				&opcodes.CreateProposal{Branch: gitdomain.NewLocalBranchName("branch")},
 				&opcodes.CreateRemoteBranch{
 					Branch: gitdomain.NewLocalBranchName("branch"),
@@ -89,6 +112,13 @@ func TestLoadSave(t *testing.T) {
 					Branch: gitdomain.NewLocalBranchName("branch"),
 					Parent: gitdomain.NewLocalBranchName("parent"),
 				},
+				&opcodes.ExecuteShellCommand{
+					Args:       []string{"arg1", "arg2"},
+					Executable: "executable",
+				},
+				&opcodes.FastForwardMerge{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+				},
 				&opcodes.FetchUpstream{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 				},
@@ -114,15 +144,25 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.RebaseFeatureTrackingBranch{
 					RemoteBranch: gitdomain.NewRemoteBranchName("origin/branch"),
 				},
+				&opcodes.RebaseOnto{
+					BranchToRebaseAgainst: gitdomain.NewBranchName("branch"),
+					CommitsToRemove:       gitdomain.NewLocation("123456"),
+				},
 				&opcodes.RemoveFromPerennialBranches{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 				},
+				&opcodes.RemoveFromPrototypeBranches{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+				},
 				&opcodes.RemoveGlobalConfig{
 					Key: gitconfig.KeyOffline,
 				},
 				&opcodes.RemoveLocalConfig{
 					Key: gitconfig.KeyOffline,
 				},
+				&opcodes.ResetCommitsInCurrentBranch{
+					Parent: gitdomain.NewLocalBranchName("parent"),
+				},
 				&opcodes.ResetCurrentBranchToSHA{
 					Hard:        true,
 					MustHaveSHA: gitdomain.NewSHA("222222"),
@@ -155,6 +195,9 @@ func TestLoadSave(t *testing.T) {
 					Parent:        gitdomain.NewLocalBranchName("parent"),
 				},
 				&opcodes.StashOpenChanges{},
+				&opcodes.UpdateProposalStacks{
+					Branches: gitdomain.NewLocalBranchNames("branch-1", "branch-2"),
+				},
 				&opcodes.UpdateProposalTarget{
 					ProposalNumber: 123,
 					NewTarget:      gitdomain.NewLocalBranchName("new-target"),
@@ -165,7 +208,7 @@ func TestLoadSave(t *testing.T) {
 				EndBranch: gitdomain.NewLocalBranchName("end-branch"),
 				EndTime:   time.Time{},
 			},
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: map[gitdomain.LocalBranchName]gitdomain.SHAs{},
 		}
 
 		wantJSON := `
@@ -208,6 +251,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "AddToPerennialBranches"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "AddToPrototypeBranches"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -225,6 +274,36 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "CommitOpenChanges"
     },
+    {
+      "data": {
+        "Message": "commit message"
+      },
+      "type": "CommitSquashedChanges"
+    },
+    {
+      "data": {
+        "Body": "proposal body",
+        "Branch": "branch",
+        "Draft": true,
+        "Title": "proposal title"
+      },
+      "type": "ConnectorCreateProposal"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "ProposalNumber": 123
+      },
+      "type": "ConnectorFastForwardProposal"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "CommitMessage": "commit message",
+        "ProposalNumber": 123
+      },
+      "type": "ConnectorMergeCommitProposal"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -249,6 +328,13 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "CreateBranch"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "CommitMessage": "commit message"
+      },
+      "type": "CreateMergeCommit"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -301,6 +387,22 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "EnsureHasShippableChanges"
     },
+    {
+      "data": {
+        "Args": [
+          "arg1",
+          "arg2"
+        ],
+        "Executable": "executable"
+      },
+      "type": "ExecuteShellCommand"
+    },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "FastForwardMerge"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -365,12 +467,25 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RebaseFeatureTrackingBranch"
     },
+    {
+      "data": {
+        "BranchToRebaseAgainst": "branch",
+        "CommitsToRemove": "123456"
+      },
+      "type": "RebaseOnto"
+    },
     {
       "data": {
         "Branch": "branch"
       },
       "type": "RemoveFromPerennialBranches"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "RemoveFromPrototypeBranches"
+    },
     {
       "data": {
         "Key": "git-town.offline"
@@ -383,6 +498,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RemoveLocalConfig"
     },
+    {
+      "data": {
+        "Parent": "parent"
+      },
+      "type": "ResetCommitsInCurrentBranch"
+    },
     {
       "data": {
         "Hard": true,
@@ -445,6 +566,15 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "StashOpenChanges"
     },
+    {
+      "data": {
+        "Branches": [
+          "branch-1",
+          "branch-2"
+        ]
+      },
+      "type": "UpdateProposalStacks"
+    },
     {
       "data": {
         "NewTarget": "new-target",
@@ -453,7 +583,7 @@ func TestLoadSave(t *testing.T) {
       "type": "UpdateProposalTarget"
     }
   ],
-  "UndoablePerennialCommits": [],
+  "UndoablePerennialCommits": {},
   "UnfinishedDetails": {
     "CanSkip": true,
     "EndBranch": "end-branch",
@@ -479,4 +609,36 @@ func TestLoadSave(t *testing.T) {
 		newStateText := fmt.Sprintf("%+v", newState)
 		must.EqOp(t, runStateText, newStateText)
 	})
+
+	t.Run("Load", func(t *testing.T) {
+		t.Parallel()
+		t.Run("undoable perennial commits by branch", func(t *testing.T) {
+			t.Parallel()
+			repoRoot := gitdomain.NewRepoRootDir("/path/to/git-town-unit-tests-commits-by-branch")
+			writeRunState(t, repoRoot, `{"Command": "ship", "UndoablePerennialCommits": {"main": ["111111", "222222"]}}`)
+			have, err := statefile.Load(repoRoot)
+			must.NoError(t, err)
+			want := runstate.UndoablePerennialCommits{
+				gitdomain.NewLocalBranchName("main"): gitdomain.SHAs{gitdomain.NewSHA("111111"), gitdomain.NewSHA("222222")},
+			}
+			must.Eq(t, want, have.UndoablePerennialCommits)
+		})
+		t.Run("legacy list of undoable perennial commits", func(t *testing.T) {
+			t.Parallel()
+			repoRoot := gitdomain.NewRepoRootDir("/path/to/git-town-unit-tests-legacy-commits")
+			writeRunState(t, repoRoot, `{"Command": "ship", "UndoablePerennialCommits": ["111111", "222222"]}`)
+			have, err := statefile.Load(repoRoot)
+			must.NoError(t, err)
+			must.EqOp(t, "ship", have.Command)
+			must.MapEmpty(t, have.UndoablePerennialCommits)
+		})
+	})
+}
+
+// writeRunState persists the given run state JSON for the given repo.
+func writeRunState(t *testing.T, repoRoot gitdomain.RepoRootDir, content string) {
+	t.Helper()
+	path, err := statefile.FilePath(repoRoot)
+	must.NoError(t, err)
+	must.NoError(t, os.WriteFile(path, []byte(content), 0o600))
 }
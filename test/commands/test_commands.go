@@ -180,6 +180,14 @@ func (self *TestCommands) CreateParkedBranches(names ...gitdomain.LocalBranchNam
 	asserts.NoError(self.Config.AddToParkedBranches(names...))
 }
 
+// CreatePrototypeBranches creates prototype branches with the given names in this repository.
+func (self *TestCommands) CreatePrototypeBranches(names ...gitdomain.LocalBranchName) {
+	for _, name := range names {
+		self.CreateFeatureBranch(name)
+	}
+	asserts.NoError(self.Config.AddToPrototypeBranches(names...))
+}
+
 // CreatePerennialBranches creates perennial branches with the given names in this repository.
 func (self *TestCommands) CreatePerennialBranches(names ...gitdomain.LocalBranchName) {
 	main := gitdomain.NewLocalBranchName("main")
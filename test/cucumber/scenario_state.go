@@ -9,6 +9,7 @@ import (
 	"github.com/git-town/git-town/v12/src/gohacks/slice"
 	"github.com/git-town/git-town/v12/test/datatable"
 	"github.com/git-town/git-town/v12/test/fixture"
+	"github.com/git-town/git-town/v12/test/githubapi"
 	"github.com/git-town/git-town/v12/test/helpers"
 )
 
@@ -17,6 +18,9 @@ type ScenarioState struct {
 	// the Fixture used in the current scenario
 	fixture fixture.Fixture
 
+	// the stand-in for the GitHub API used in the current scenario, nil if the scenario doesn't use one
+	githubAPI *githubapi.StandIn
+
 	// initialCommits describes the commits in this Git environment before the WHEN steps ran.
 	initialCommits *messages.PickleStepArgument_PickleTable
 
@@ -85,6 +89,7 @@ func (self *ScenarioState) InitialBranches() datatable.DataTable {
 // Reset restores the null value of this ScenarioState.
 func (self *ScenarioState) Reset(gitEnv fixture.Fixture) {
 	self.fixture = gitEnv
+	self.githubAPI = nil
 	self.initialLocalBranches = gitdomain.NewLocalBranchNames("main")
 	self.initialRemoteBranches = gitdomain.NewLocalBranchNames("main")
 	self.initialDevSHAs = map[string]gitdomain.SHA{}
@@ -28,6 +28,7 @@ import (
 	"github.com/git-town/git-town/v12/test/datatable"
 	"github.com/git-town/git-town/v12/test/fixture"
 	"github.com/git-town/git-town/v12/test/git"
+	"github.com/git-town/git-town/v12/test/githubapi"
 	"github.com/git-town/git-town/v12/test/helpers"
 	"github.com/git-town/git-town/v12/test/output"
 	"github.com/git-town/git-town/v12/test/subshell"
@@ -76,6 +77,9 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 	})
 
 	suite.AfterScenario(func(scenario *messages.Pickle, e error) {
+		if state.githubAPI != nil {
+			state.githubAPI.Close()
+		}
 		if e != nil {
 			fmt.Printf("failed scenario %q in %s - investigate state in %s\n", scenario.GetName(), scenario.GetUri(), state.fixture.Dir)
 		}
@@ -96,13 +100,26 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
-	suite.Step(`^a feature branch "([^"]+)" as a child of "([^"]+)"$`, func(branchText, parentBranch string) error {
+	suite.Step(`^a (local )?feature branch "([^"]+)" as a child of "([^"]+)"$`, func(localStr, branchText, parentBranch string) error {
 		branch := gitdomain.NewLocalBranchName(branchText)
+		isLocal := localStr != ""
 		state.fixture.DevRepo.CreateChildFeatureBranch(branch, gitdomain.NewLocalBranchName(parentBranch))
 		state.initialLocalBranches = append(state.initialLocalBranches, branch)
-		state.initialRemoteBranches = append(state.initialRemoteBranches, branch)
 		state.initialLineage.AddRow(branchText, parentBranch)
-		state.fixture.DevRepo.PushBranchToRemote(branch, gitdomain.OriginRemote)
+		if !isLocal {
+			state.initialRemoteBranches = append(state.initialRemoteBranches, branch)
+			state.fixture.DevRepo.PushBranchToRemote(branch, gitdomain.OriginRemote)
+		}
+		return nil
+	})
+
+	suite.Step(`^a GitHub API stand-in with the proposals$`, func(table *messages.PickleStepArgument_PickleTable) error {
+		pullRequests, err := githubapi.ParsePullRequests(datatable.FromGherkin(table))
+		if err != nil {
+			return err
+		}
+		state.githubAPI = githubapi.New(pullRequests)
+		state.fixture.DevRepo.SetTestGitHubAPIURL(state.githubAPI.URL())
 		return nil
 	})
 
@@ -265,6 +282,18 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^branch "([^"]+)" is (?:now|still) a prototype branch`, func(name string) error {
+		branch := gitdomain.NewLocalBranchName(name)
+		if !state.fixture.DevRepo.Config.FullConfig.IsPrototypeBranch(branch) {
+			return fmt.Errorf(
+				"branch %q isn't a prototype branch as expected.\nPrototype branches: %s",
+				branch,
+				strings.Join(state.fixture.DevRepo.Config.FullConfig.PrototypeBranches.Strings(), ", "),
+			)
+		}
+		return nil
+	})
+
 	suite.Step(`^branch "([^"]+)" is (?:now|still) perennial`, func(name string) error {
 		branch := gitdomain.NewLocalBranchName(name)
 		if !state.fixture.DevRepo.Config.FullConfig.IsPerennialBranch(branch) {
@@ -291,6 +320,9 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		if state.fixture.DevRepo.Config.FullConfig.IsPerennialBranch(branch) {
 			return fmt.Errorf("branch %q is perennial", branch)
 		}
+		if state.fixture.DevRepo.Config.FullConfig.IsPrototypeBranch(branch) {
+			return fmt.Errorf("branch %q is prototype", branch)
+		}
 		return nil
 	})
 
@@ -800,6 +832,51 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^local Git Town setting "azure-devops-token" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.AzureDevOpsToken
+		want := configdomain.AzureDevOpsToken(wantStr)
+		if *have != want {
+			return fmt.Errorf(`expected local setting "azure-devops-token" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "bitbucket-app-password" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.BitbucketAppPassword
+		want := configdomain.BitbucketAppPassword(wantStr)
+		if *have != want {
+			return fmt.Errorf(`expected local setting "bitbucket-app-password" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "bitbucket-datacenter-token" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.BitbucketDatacenterToken
+		want := configdomain.BitbucketDatacenterToken(wantStr)
+		if *have != want {
+			return fmt.Errorf(`expected local setting "bitbucket-datacenter-token" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "bitbucket-username" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.BitbucketUsername
+		want := configdomain.BitbucketUsername(wantStr)
+		if *have != want {
+			return fmt.Errorf(`expected local setting "bitbucket-username" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "forgejo-token" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.ForgejoToken
+		want := configdomain.ForgejoToken(wantStr)
+		if *have != want {
+			return fmt.Errorf(`expected local setting "forgejo-token" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^local Git Town setting "gitea-token" is now "([^"]*)"$`, func(wantStr string) error {
 		have := state.fixture.DevRepo.Config.LocalGitConfig.GiteaToken
 		want := configdomain.GiteaToken(wantStr)
@@ -1250,7 +1327,7 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
-	suite.Step(`^the current branch is an? (local )?(feature|perennial|parked|contribution|observed) branch "([^"]*)"$`, func(localStr, branchType, branchName string) error {
+	suite.Step(`^the current branch is an? (local )?(feature|perennial|parked|contribution|observed|prototype) branch "([^"]*)"$`, func(localStr, branchType, branchName string) error {
 		branch := gitdomain.NewLocalBranchName(branchName)
 		isLocal := localStr != ""
 		switch branchType {
@@ -1266,6 +1343,9 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 			state.fixture.DevRepo.CreateContributionBranches(branch)
 		case "observed":
 			state.fixture.DevRepo.CreateObservedBranches(branch)
+		case "prototype":
+			state.fixture.DevRepo.CreatePrototypeBranches(branch)
+			state.initialLineage.AddRow(branchName, "main")
 		default:
 			panic(fmt.Sprintf("unknown branch type: %q", branchType))
 		}
@@ -1303,6 +1383,20 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^the GitHub API stand-in received these changes$`, func(table *messages.PickleStepArgument_PickleTable) error {
+		if state.githubAPI == nil {
+			return errors.New("this scenario doesn't use a GitHub API stand-in")
+		}
+		changes := state.githubAPI.ChangesTable()
+		diff, errCount := changes.EqualGherkin(table)
+		if errCount > 0 {
+			fmt.Printf("\nERROR! Found %d differences in the changes received by the GitHub API\n\n", errCount)
+			fmt.Println(diff)
+			return errors.New("mismatching GitHub API changes found, see the diff above")
+		}
+		return nil
+	})
+
 	suite.Step(`^the initial lineage exists$`, func() error {
 		have := state.fixture.DevRepo.LineageTable()
 		state.initialLineage.Sort()
@@ -1530,6 +1624,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^there are (?:now|still) no prototype branches$`, func() error {
+		branches := state.fixture.DevRepo.Config.LocalGitConfig.PrototypeBranches
+		if branches != nil && len(*branches) > 0 {
+			return fmt.Errorf("expected no prototype branches, got %q", branches)
+		}
+		return nil
+	})
+
 	suite.Step(`^there are (?:now|still) no parked branches$`, func() error {
 		branches := state.fixture.DevRepo.Config.LocalGitConfig.ParkedBranches
 		if branches != nil && len(*branches) > 0 {
@@ -0,0 +1,267 @@
+// Package githubapi provides a stand-in for the GitHub API that end-to-end tests run Git Town against.
+package githubapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/git-town/git-town/v12/test/datatable"
+)
+
+// pullsPathRE matches the API paths of the pull requests of a repository.
+var pullsPathRE = regexp.MustCompile(`^/repos/([^/]+)/([^/]+)/pulls(?:/(\d+))?$`) //nolint:gochecknoglobals
+
+// StandIn is a stand-in for the GitHub API.
+// It serves the pull requests it knows about and records the requests that change them.
+type StandIn struct {
+	changes      []Change
+	mutex        sync.Mutex
+	pullRequests []PullRequest
+	server       *httptest.Server
+}
+
+// New provides a running StandIn that knows the given pull requests.
+func New(pullRequests []PullRequest) *StandIn {
+	result := StandIn{
+		changes:      []Change{},
+		mutex:        sync.Mutex{},
+		pullRequests: pullRequests,
+		server:       nil,
+	}
+	result.server = httptest.NewServer(http.HandlerFunc(result.serve))
+	return &result
+}
+
+// Change describes a request that changed the pull requests of a StandIn.
+type Change struct {
+	Body   string // the JSON body of the request
+	Method string
+	Path   string
+}
+
+// PullRequest describes a pull request that a StandIn knows about.
+type PullRequest struct {
+	Body   string
+	Branch string
+	Number int
+	State  string
+	Target string
+	Title  string
+}
+
+// ChangesTable provides the changes that this StandIn received as a DataTable.
+func (self *StandIn) ChangesTable() datatable.DataTable {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	result := datatable.DataTable{}
+	result.AddRow("METHOD", "PATH", "BODY")
+	for _, change := range self.changes {
+		result.AddRow(change.Method, change.Path, change.Body)
+	}
+	return result
+}
+
+// Close shuts down this StandIn.
+func (self *StandIn) Close() {
+	self.server.Close()
+}
+
+// URL provides the base URL of this StandIn.
+func (self *StandIn) URL() string {
+	return self.server.URL
+}
+
+func (self *StandIn) createPullRequest(org, repo string, body []byte) (PullRequest, error) {
+	var payload struct {
+		Base  string `json:"base"`
+		Body  string `json:"body"`
+		Head  string `json:"head"`
+		Title string `json:"title"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return PullRequest{}, err //nolint:exhaustruct
+	}
+	pullRequest := PullRequest{
+		Body:   payload.Body,
+		Branch: strings.TrimPrefix(payload.Head, org+":"),
+		Number: len(self.pullRequests) + 1,
+		State:  "open",
+		Target: payload.Base,
+		Title:  payload.Title,
+	}
+	self.pullRequests = append(self.pullRequests, pullRequest)
+	return pullRequest, nil
+}
+
+func (self *StandIn) findPullRequests(org string, query map[string][]string) []PullRequest {
+	result := []PullRequest{}
+	for _, pullRequest := range self.pullRequests {
+		if head := firstValue(query["head"]); head != "" && head != org+":"+pullRequest.Branch {
+			continue
+		}
+		if base := firstValue(query["base"]); base != "" && base != pullRequest.Target {
+			continue
+		}
+		if state := firstValue(query["state"]); state != "" && state != pullRequest.State {
+			continue
+		}
+		result = append(result, pullRequest)
+	}
+	return result
+}
+
+func (self *StandIn) serve(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	match := pullsPathRE.FindStringSubmatch(request.URL.Path)
+	if match == nil {
+		writeJSON(writer, http.StatusNotFound, map[string]any{"message": "Not Found"})
+		return
+	}
+	org, repo, numberText := match[1], match[2], match[3]
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		writeJSON(writer, http.StatusBadRequest, map[string]any{"message": err.Error()})
+		return
+	}
+	if request.Method != http.MethodGet {
+		self.changes = append(self.changes, Change{
+			Body:   strings.TrimSpace(string(body)),
+			Method: request.Method,
+			Path:   request.URL.Path,
+		})
+	}
+	switch {
+	case request.Method == http.MethodGet && numberText == "":
+		pullRequests := self.findPullRequests(org, request.URL.Query())
+		result := make([]map[string]any, len(pullRequests))
+		for p, pullRequest := range pullRequests {
+			result[p] = pullRequest.json(org, repo)
+		}
+		writeJSON(writer, http.StatusOK, result)
+	case request.Method == http.MethodPost && numberText == "":
+		pullRequest, err := self.createPullRequest(org, repo, body)
+		if err != nil {
+			writeJSON(writer, http.StatusUnprocessableEntity, map[string]any{"message": err.Error()})
+			return
+		}
+		writeJSON(writer, http.StatusCreated, pullRequest.json(org, repo))
+	case request.Method == http.MethodPatch && numberText != "":
+		number, _ := strconv.Atoi(numberText)
+		pullRequest, err := self.updatePullRequest(number, body)
+		if err != nil {
+			writeJSON(writer, http.StatusUnprocessableEntity, map[string]any{"message": err.Error()})
+			return
+		}
+		writeJSON(writer, http.StatusOK, pullRequest.json(org, repo))
+	default:
+		writeJSON(writer, http.StatusNotFound, map[string]any{"message": "Not Found"})
+	}
+}
+
+func (self *StandIn) updatePullRequest(number int, body []byte) (PullRequest, error) {
+	var payload struct {
+		Base  *string `json:"base"`
+		Body  *string `json:"body"`
+		State *string `json:"state"`
+		Title *string `json:"title"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return PullRequest{}, err //nolint:exhaustruct
+	}
+	for p := range self.pullRequests {
+		pullRequest := &self.pullRequests[p]
+		if pullRequest.Number != number {
+			continue
+		}
+		if payload.Base != nil {
+			pullRequest.Target = *payload.Base
+		}
+		if payload.Body != nil {
+			pullRequest.Body = *payload.Body
+		}
+		if payload.State != nil {
+			pullRequest.State = *payload.State
+		}
+		if payload.Title != nil {
+			pullRequest.Title = *payload.Title
+		}
+		return *pullRequest, nil
+	}
+	return PullRequest{}, fmt.Errorf("pull request #%d not found", number) //nolint:exhaustruct
+}
+
+// json provides the representation of this PullRequest in the GitHub API.
+func (self PullRequest) json(org, repo string) map[string]any {
+	return map[string]any{
+		"base":     map[string]any{"ref": self.Target},
+		"body":     self.Body,
+		"head":     map[string]any{"ref": self.Branch},
+		"html_url": fmt.Sprintf("https://github.com/%s/%s/pull/%d", org, repo, self.Number),
+		"number":   self.Number,
+		"state":    self.State,
+		"title":    self.Title,
+	}
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func writeJSON(writer http.ResponseWriter, status int, data any) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(data)
+}
+
+// ParsePullRequests provides the open pull requests described by the given table.
+// The table must have the columns NUMBER, BRANCH, and TARGET, and can have the columns TITLE and BODY.
+func ParsePullRequests(table datatable.DataTable) ([]PullRequest, error) {
+	result := []PullRequest{}
+	if len(table.Cells) == 0 {
+		return result, nil
+	}
+	headers := table.Cells[0]
+	for _, row := range table.Cells[1:] {
+		pullRequest := PullRequest{
+			Body:   "",
+			Branch: "",
+			Number: 0,
+			State:  "open",
+			Target: "",
+			Title:  "",
+		}
+		for c, header := range headers {
+			switch header {
+			case "BODY":
+				pullRequest.Body = row[c]
+			case "BRANCH":
+				pullRequest.Branch = row[c]
+			case "NUMBER":
+				number, err := strconv.Atoi(row[c])
+				if err != nil {
+					return result, fmt.Errorf("invalid pull request number %q: %w", row[c], err)
+				}
+				pullRequest.Number = number
+			case "TARGET":
+				pullRequest.Target = row[c]
+			case "TITLE":
+				pullRequest.Title = row[c]
+			default:
+				return result, fmt.Errorf("unknown pull request table column: %q", header)
+			}
+		}
+		result = append(result, pullRequest)
+	}
+	return result, nil
+}
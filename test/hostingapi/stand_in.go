@@ -0,0 +1,22 @@
+// Package hostingapi provides stand-ins for the APIs of code hosting platforms that the unit tests of the connectors run against.
+package hostingapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+// NewConnector starts a stand-in server that answers API requests using the given handler
+// and provides the connector that the given constructor creates for the URL of that server.
+// The server stops when the given test ends.
+func NewConnector[C any](t *testing.T, handler http.HandlerFunc, newConnector func(url string) (C, error)) C {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	connector, err := newConnector(server.URL)
+	must.NoError(t, err)
+	return connector
+}
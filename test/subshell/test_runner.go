@@ -38,6 +38,9 @@ type TestRunner struct {
 	// name of the binary to use as the custom editor during "git commit"
 	gitEditor string `exhaustruct:"optional"`
 
+	// optional content of the GIT_TOWN_GITHUB_API_URL environment variable
+	testGitHubAPIURL string `exhaustruct:"optional"`
+
 	// optional content of the GIT_TOWN_REMOTE environment variable
 	testOrigin string `exhaustruct:"optional"`
 
@@ -197,6 +200,10 @@ func (self *TestRunner) QueryWithCode(opts *Options, cmd string, args ...string)
 	if self.testOrigin != "" {
 		opts.Env = envvars.Replace(opts.Env, "GIT_TOWN_REMOTE", self.testOrigin)
 	}
+	// add the custom GitHub API
+	if self.testGitHubAPIURL != "" {
+		opts.Env = envvars.Replace(opts.Env, "GIT_TOWN_GITHUB_API_URL", self.testGitHubAPIURL)
+	}
 	// add the custom bin dir to the PATH
 	if self.usesBinDir {
 		opts.Env = envvars.PrependPath(opts.Env, self.BinDir)
@@ -259,6 +266,11 @@ func (self *TestRunner) RunMany(commands [][]string) error {
 	return nil
 }
 
+// SetTestGitHubAPIURL makes subsequent runs of commands talk to the GitHub API at the given URL.
+func (self *TestRunner) SetTestGitHubAPIURL(url string) {
+	self.testGitHubAPIURL = url
+}
+
 // SetTestOrigin adds the given environment variable to subsequent runs of commands.
 func (self *TestRunner) SetTestOrigin(content string) {
 	self.testOrigin = content